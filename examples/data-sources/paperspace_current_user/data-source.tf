@@ -0,0 +1,6 @@
+# List teams of the API key user, e.g. to choose the provider team_namespace
+data "paperspace_current_user" "me" {}
+
+output "team_namespaces" {
+  value = [for membership in data.paperspace_current_user.me.team_memberships : membership.namespace]
+}
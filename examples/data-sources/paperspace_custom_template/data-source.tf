@@ -0,0 +1,5 @@
+# Find the most recent template created from a machine
+data "paperspace_custom_template" "latest" {
+  parent_machine_id = "ps0123456789"
+  most_recent       = true
+}
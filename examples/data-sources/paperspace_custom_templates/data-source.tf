@@ -1,3 +1,10 @@
 
 # List all custom templates
 data "paperspace_custom_templates" "all" {}
+
+# List templates in the region which can run on C5 machines
+data "paperspace_custom_templates" "ny2_c5" {
+  region       = "ny2"
+  machine_type = "C5"
+  name_regex   = "^ubuntu-"
+}
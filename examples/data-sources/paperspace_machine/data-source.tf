@@ -0,0 +1,8 @@
+# Look up a machine managed in another Terraform state
+data "paperspace_machine" "gpu" {
+  name = "training-gpu"
+}
+
+output "gpu_public_ip" {
+  value = data.paperspace_machine.gpu.public_ip
+}
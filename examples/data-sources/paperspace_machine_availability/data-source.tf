@@ -0,0 +1,5 @@
+# Check whether A4000 machines can be created in NY2
+data "paperspace_machine_availability" "a4000" {
+  region       = "ny2"
+  machine_type = "A4000"
+}
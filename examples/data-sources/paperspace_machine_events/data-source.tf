@@ -0,0 +1,9 @@
+# Count failed machine events during the last hour
+data "paperspace_machine_events" "failed" {
+  state   = "error"
+  max_age = "1h"
+}
+
+output "failed_machine_events" {
+  value = data.paperspace_machine_events.failed.name_counts
+}
@@ -0,0 +1,11 @@
+# Running GPU machines in NY2
+data "paperspace_machines" "gpu" {
+  name_regex   = "^training-"
+  region       = "ny2"
+  machine_type = "A4000"
+  state        = "ready"
+}
+
+output "gpu_private_ips" {
+  value = [for machine in data.paperspace_machines.gpu.machines : machine.private_ip]
+}
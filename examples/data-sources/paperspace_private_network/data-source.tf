@@ -0,0 +1,5 @@
+# Find a private network by name
+data "paperspace_private_network" "example" {
+  name   = "Example Network"
+  region = "ny2"
+}
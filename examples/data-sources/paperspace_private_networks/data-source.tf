@@ -0,0 +1,4 @@
+# List all private networks in the region
+data "paperspace_private_networks" "ny2" {
+  region = "ny2"
+}
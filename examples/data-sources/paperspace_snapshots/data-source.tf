@@ -0,0 +1,5 @@
+# List snapshots of the machine taken during the last week
+data "paperspace_snapshots" "recent" {
+  machine_id = paperspace_machine.example.id
+  max_age    = "168h"
+}
@@ -0,0 +1,10 @@
+# Fail early when the API key of another team is used
+provider "paperspace" {
+  team_namespace = "research"
+}
+
+data "paperspace_team" "current" {}
+
+output "max_machines" {
+  value = data.paperspace_team.current.max_machines
+}
@@ -0,0 +1,2 @@
+# Custom template can be imported by specifying the identifier.
+terraform import paperspace_custom_template.example t0123456789
@@ -0,0 +1,5 @@
+# Bake a template from a machine, the machine is stopped if it's running
+resource "paperspace_custom_template" "example" {
+  name       = "Example Template"
+  machine_id = paperspace_machine.example.id
+}
@@ -1,3 +1,6 @@
-
 # Machine can be imported by specifying the identifier.
-terraform import paperspace_machine.example ps0123456789
\ No newline at end of file
+terraform import paperspace_machine.example ps0123456789
+
+# Template ID is not returned by the Paperspace API, so it may be passed after the machine identifier,
+# otherwise the imported machine will be replaced on the next apply.
+terraform import paperspace_machine.example ps0123456789:tkni3aa4
@@ -0,0 +1,2 @@
+# Private network can be imported by specifying the identifier.
+terraform import paperspace_private_network.example pn0123456789
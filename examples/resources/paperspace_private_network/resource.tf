@@ -0,0 +1,5 @@
+# Manage example private network
+resource "paperspace_private_network" "example" {
+  name   = "Example Network"
+  region = "ny2"
+}
@@ -0,0 +1,2 @@
+# Snapshot can be imported by specifying the identifier.
+terraform import paperspace_snapshot.example snp0123456789
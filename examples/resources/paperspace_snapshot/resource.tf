@@ -0,0 +1,5 @@
+# Snapshot the machine before a risky change
+resource "paperspace_snapshot" "example" {
+  name       = "Before upgrade"
+  machine_id = paperspace_machine.example.id
+}
@@ -0,0 +1,2 @@
+# Startup script can be imported by specifying the identifier.
+terraform import paperspace_startup_script.example scr0123456789
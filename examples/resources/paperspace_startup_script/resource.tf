@@ -0,0 +1,6 @@
+# Manage example startup script
+resource "paperspace_startup_script" "example" {
+  name        = "Example Name"
+  script      = "echo 'Hello, World!'"
+  is_run_once = false
+}
@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &currentUserDataSource{}
+	_ datasource.DataSourceWithConfigure = &currentUserDataSource{}
+)
+
+// NewCurrentUserDataSource is a helper function to simplify the provider implementation.
+func NewCurrentUserDataSource() datasource.DataSource {
+	return &currentUserDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type currentUserDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *currentUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_current_user"
+}
+
+//// Data model types
+
+// currentUserDataSourceModel maps the data source schema data.
+type currentUserDataSourceModel struct {
+	ID              types.String          `tfsdk:"id"`
+	Email           types.String          `tfsdk:"email"`
+	FirstName       types.String          `tfsdk:"first_name"`
+	LastName        types.String          `tfsdk:"last_name"`
+	DtCreated       types.String          `tfsdk:"dt_created"`
+	TeamMemberships []teamMembershipModel `tfsdk:"team_memberships"`
+}
+
+// teamMembershipModel maps team membership schema data.
+type teamMembershipModel struct {
+	TeamID     types.String `tfsdk:"team_id"`
+	Name       types.String `tfsdk:"name"`
+	Namespace  types.String `tfsdk:"namespace"`
+	IsUserTeam types.Bool   `tfsdk:"is_user_team"`
+	IsOwner    types.Bool   `tfsdk:"is_owner"`
+	IsAdmin    types.Bool   `tfsdk:"is_admin"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+func (d *currentUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "User of the provider API key and the teams the user is a member of, e.g. to choose `team_id` or `team_namespace` of the provider.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the user.",
+				Computed:            true,
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the user.",
+				Computed:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the user.",
+				Computed:            true,
+			},
+			"dt_created": schema.StringAttribute{
+				MarkdownDescription: "Date of the user creation.",
+				Computed:            true,
+			},
+			"team_memberships": schema.ListNestedAttribute{
+				MarkdownDescription: "Teams the user is a member of.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the team.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the team.",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Namespace (handle) of the team.",
+							Computed:            true,
+						},
+						"is_user_team": schema.BoolAttribute{
+							MarkdownDescription: "Whether the team is the personal team of the user.",
+							Computed:            true,
+						},
+						"is_owner": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user owns the team.",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is an admin of the team.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *currentUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	authSession, err := d.client.Authenticate(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace User",
+			err.Error(),
+		)
+		return
+	}
+
+	user := authSession.User
+
+	state := currentUserDataSourceModel{
+		ID:              types.StringValue(user.ID),
+		Email:           types.StringValue(user.Email),
+		FirstName:       types.StringValue(user.FirstName),
+		LastName:        types.StringValue(user.LastName),
+		DtCreated:       types.StringValue(user.DtCreated),
+		TeamMemberships: []teamMembershipModel{},
+	}
+
+	for _, membership := range user.TeamMemberships {
+		state.TeamMemberships = append(state.TeamMemberships, teamMembershipModel{
+			TeamID:     types.StringValue(membership.Team.ID),
+			Name:       types.StringValue(membership.Team.Name),
+			Namespace:  types.StringValue(membership.Team.Namespace),
+			IsUserTeam: types.BoolValue(membership.Team.IsUserTeam),
+			IsOwner:    types.BoolValue(membership.IsOwner),
+			IsAdmin:    types.BoolValue(membership.IsAdmin),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *currentUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccCurrentUserDataSourceConfig = `
+data "paperspace_current_user" "test" {}
+`
+
+func TestAccCurrentUserDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccCurrentUserDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+					"data.paperspace_current_user.test",
+					map[string]string{
+						"id":                           "_any_",
+						"email":                        "_any_",
+						"team_memberships.#":           "_any_",
+						"team_memberships.0.team_id":   "_any_",
+						"team_memberships.0.namespace": "_any_",
+					},
+				)...),
+			},
+		},
+	})
+}
+
+func TestCurrentUserDataSource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(fake) + testAccCurrentUserDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+					"data.paperspace_current_user.test",
+					map[string]string{
+						"id":                              "psu0000",
+						"email":                           "test@example.com",
+						"first_name":                      "Test",
+						"last_name":                       "User",
+						"team_memberships.#":              "1",
+						"team_memberships.0.team_id":      "pst0000",
+						"team_memberships.0.name":         "test",
+						"team_memberships.0.namespace":    "test",
+						"team_memberships.0.is_user_team": "true",
+						"team_memberships.0.is_owner":     "true",
+						"team_memberships.0.is_admin":     "true",
+					},
+				)...),
+			},
+		},
+	})
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &customTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &customTemplateDataSource{}
+)
+
+// NewCustomTemplateDataSource is a helper function to simplify the provider implementation.
+func NewCustomTemplateDataSource() datasource.DataSource {
+	return &customTemplateDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type customTemplateDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *customTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_template"
+}
+
+//// Data model types
+
+// customTemplateDataSourceModel maps the data source schema data.
+type customTemplateDataSourceModel struct {
+	// Filters, which are also filled with the found template data
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Region               types.String `tfsdk:"region"`
+	AgentType            types.String `tfsdk:"agent_type"`
+	OperatingSystemLabel types.String `tfsdk:"operating_system_label"`
+	ParentMachineID      types.String `tfsdk:"parent_machine_id"`
+
+	// Filters only
+	NameRegex   types.String `tfsdk:"name_regex"`
+	MachineType types.String `tfsdk:"machine_type"`
+	MostRecent  types.Bool   `tfsdk:"most_recent"`
+
+	// Computed only
+	DefaultSizeGb        types.Int64                 `tfsdk:"default_size_gb"`
+	AvailableMachineType []availableMachineTypeModel `tfsdk:"available_machine_type"`
+	DtCreated            types.String                `tfsdk:"dt_created"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// Filter arguments are the same as in paperspace_custom_templates, plus lookup by ID and most_recent selector.
+func (d *customTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := customTemplateDataSourceAttributes()
+
+	// Filters which are filled with the found template data
+	for name, filterAttribute := range customTemplateFilterAttributes() {
+		filter := filterAttribute.(schema.StringAttribute)
+		if _, ok := attributes[name]; ok {
+			filter.Computed = true
+		}
+		attributes[name] = filter
+	}
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Match template with this ID.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["most_recent"] = schema.BoolAttribute{
+		MarkdownDescription: "If more than one template matches, use the most recently created one instead of failing. Defaults to `false`.",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single custom template. All filters must match, and the lookup fails if no template or more than one template matches, unless `most_recent` is set.",
+		Attributes:          attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *customTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state customTemplateDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := customTemplateFilter{
+		ID:                   state.ID.ValueString(),
+		Name:                 state.Name.ValueString(),
+		NameRegex:            compileRegex(state.NameRegex),
+		Region:               state.Region.ValueString(),
+		AgentType:            state.AgentType.ValueString(),
+		OperatingSystemLabel: state.OperatingSystemLabel.ValueString(),
+		MachineType:          state.MachineType.ValueString(),
+		ParentMachineID:      state.ParentMachineID.ValueString(),
+	}
+
+	customTemplates, err := d.client.GetCustomTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace CustomTemplates",
+			err.Error(),
+		)
+		return
+	}
+
+	// Templates are sorted by creation date, so the last one is the most recent
+	found := filter.apply(*customTemplates)
+	switch {
+	case len(found) == 0:
+		resp.Diagnostics.AddError(
+			"Custom Template Not Found",
+			"No custom template matches the given filters.",
+		)
+		return
+	case len(found) > 1 && !state.MostRecent.ValueBool():
+		resp.Diagnostics.AddError(
+			"Unable to Find Single Custom Template",
+			fmt.Sprintf("Expected exactly one custom template, found %d. Narrow the filters or set most_recent = true.", len(found)),
+		)
+		return
+	}
+
+	customTemplate := newCustomTemplateModel(&found[len(found)-1])
+
+	state.ID = customTemplate.ID
+	state.Name = customTemplate.Name
+	state.AgentType = customTemplate.AgentType
+	state.OperatingSystemLabel = customTemplate.OperatingSystemLabel
+	state.ParentMachineID = customTemplate.ParentMachineID
+	state.DefaultSizeGb = customTemplate.DefaultSizeGb
+	state.AvailableMachineType = customTemplate.AvailableMachineType
+	state.DtCreated = customTemplate.DtCreated
+
+	// Keep region as it's written in configuration, it matches the found one
+	if state.Region.IsNull() {
+		state.Region = customTemplate.Region
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *customTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
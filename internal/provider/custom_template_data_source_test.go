@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomTemplateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Most recent template must be the last one of the filtered list
+			{
+				Config: providerConfig + `
+data "paperspace_custom_templates" "test" {
+  region = "ny2"
+}
+
+data "paperspace_custom_template" "test" {
+  region      = "ny2"
+  most_recent = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.paperspace_custom_template.test", "id"),
+					resource.TestCheckResourceAttrSet("data.paperspace_custom_template.test", "dt_created"),
+					resource.TestCheckResourceAttr("data.paperspace_custom_template.test", "region", "ny2"),
+				),
+			},
+			// Lookup without match must fail
+			{
+				Config: providerConfig + `
+data "paperspace_custom_template" "test" {
+  name = "paperspace-provider-test-missing-template"
+}
+`,
+				ExpectError: regexp.MustCompile("Custom Template Not Found"),
+			},
+		},
+	})
+}
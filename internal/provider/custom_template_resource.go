@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"terraform-provider-paperspace/internal/psclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &customTemplateResource{}
+	_ resource.ResourceWithConfigure   = &customTemplateResource{}
+	_ resource.ResourceWithImportState = &customTemplateResource{}
+)
+
+// Default operation timeouts, may be overridden with the timeouts block.
+// Creation includes stopping the machine and baking its disk into the template.
+const (
+	defaultCustomTemplateCreateTimeout = 60 * time.Minute
+	defaultCustomTemplateReadTimeout   = 5 * time.Minute
+	defaultCustomTemplateUpdateTimeout = 5 * time.Minute
+	defaultCustomTemplateDeleteTimeout = 15 * time.Minute
+)
+
+// Attribute types of available_machine_types list elements.
+var availableMachineTypeAttrTypes = map[string]attr.Type{
+	"machine_type_label": types.StringType,
+	"is_available":       types.BoolType,
+}
+
+// NewCustomTemplateResource is a helper function to simplify the provider implementation.
+func NewCustomTemplateResource() resource.Resource {
+	return &customTemplateResource{}
+}
+
+// Maps the resource schema data.
+// State/Plan structure.
+type customTemplateResourceModel struct {
+	Name      types.String `tfsdk:"name"`       // required
+	MachineID types.String `tfsdk:"machine_id"` // required
+
+	// Computed only
+	ID                    types.String `tfsdk:"id"`
+	AgentType             types.String `tfsdk:"agent_type"`
+	OperatingSystemLabel  types.String `tfsdk:"operating_system_label"`
+	Region                types.String `tfsdk:"region"`
+	DefaultSizeGb         types.Int64  `tfsdk:"default_size_gb"`
+	AvailableMachineTypes types.List   `tfsdk:"available_machine_types"`
+	DtCreated             types.String `tfsdk:"dt_created"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// customTemplateResource is the resource implementation.
+type customTemplateResource struct {
+	// Allow resource to store a reference to the client
+	client *psclient.Client
+}
+
+// Define the resource type name, which is how the resource is used in Terraform configurations.
+func (r *customTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_template"
+}
+
+// Define Schema
+// The resource uses the Schema method to define the supported configuration, plan, and state attribute names and types.
+func (r *customTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom template resource. The template is created from the disk of an existing machine. " +
+			"The machine is stopped before creation if it's running, and is started again afterwards, even if the creation fails. " +
+			"If only starting the machine fails, the template is kept and a warning is reported.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the template.",
+				Required:            true,
+			},
+			"machine_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the machine to create the template from. Forces resource replacement if changed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed only
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the template.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"agent_type": schema.StringAttribute{
+				MarkdownDescription: "The agent type of the template.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"operating_system_label": schema.StringAttribute{
+				MarkdownDescription: "The operating system label of the template.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region of the template.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"default_size_gb": schema.Int64Attribute{
+				MarkdownDescription: "The default disk size of machines created from the template in GB.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"available_machine_types": schema.ListNestedAttribute{
+				MarkdownDescription: "The machine types the template can be used with.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"machine_type_label": schema.StringAttribute{
+							MarkdownDescription: "The machine type, e.g. `C5`.",
+							Computed:            true,
+						},
+						"is_available": schema.BoolAttribute{
+							MarkdownDescription: "Whether the machine type is currently available for the template.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"dt_created": schema.StringAttribute{
+				MarkdownDescription: "The date the template was created.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *customTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan customTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCustomTemplateCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan and create new template
+
+	reqData := psclient.CustomTemplateCreateConfig{
+		Name:      plan.Name.ValueString(),      // required
+		MachineID: plan.MachineID.ValueString(), // required
+	}
+
+	jsonData, _ := json.MarshalIndent(reqData, "", " ")
+	tflog.Info(ctx, "Sending create req data: "+string(jsonData))
+
+	customTemplate, err := r.client.CreateCustomTemplate(ctx, reqData)
+
+	// Template is usable even if the source machine wasn't started again, so don't taint it
+	var startErr *psclient.MachineStartError
+	if errors.As(err, &startErr) {
+		resp.Diagnostics.AddWarning(
+			"Machine not started after creating custom template",
+			fmt.Sprintf("Custom template %s was created, but machine %s, stopped for the creation, could not be started again: %s", customTemplate.ID, startErr.MachineID, startErr.Err),
+		)
+		err = nil
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom template",
+			"Could not create custom template, unexpected error: "+err.Error(),
+		)
+
+		// Template is created, but not usable yet. Save it to state, so it's tainted instead of leaked
+		if customTemplate != nil && customTemplate.ID != "" {
+			resp.Diagnostics.Append(fillStateWithCustomTemplateData(ctx, &plan, customTemplate)...)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+		return
+	}
+	tflog.Info(ctx, "Created a custom template resource with id "+customTemplate.ID)
+
+	resp.Diagnostics.Append(fillStateWithCustomTemplateData(ctx, &plan, customTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state customTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultCustomTemplateReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed data from Paperspace
+	customTemplate, err := r.client.GetCustomTemplate(ctx, state.ID.ValueString())
+	if err != nil {
+		// Avoid error due to 404 status. Resource could be deleted outside provider, so handle this as expected case.
+		if psclient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Custom template %s not found, removing from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Paperspace custom template",
+			"Could not read Paperspace custom template ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fillStateWithCustomTemplateData(ctx, &state, customTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Updates the resource and sets the updated Terraform state on success.
+func (r *customTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Fetch the entire plan and prior state
+	var plan, state customTemplateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultCustomTemplateUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	customTemplateID := state.ID.ValueString()
+
+	// Name is the only attribute which may be updated in place
+	if !plan.Name.Equal(state.Name) {
+		reqData := psclient.CustomTemplateUpdateConfig{
+			Name: plan.Name.ValueString(),
+		}
+
+		_, err := r.client.UpdateCustomTemplate(ctx, customTemplateID, reqData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating custom template",
+				"Could not update custom template, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Fetch updated template
+	customTemplate, err := r.client.GetCustomTemplate(ctx, customTemplateID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading updated Paperspace custom template",
+			"Could not read Paperspace custom template ID "+customTemplateID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fillStateWithCustomTemplateData(ctx, &plan, customTemplate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state customTemplateResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultCustomTemplateDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteCustomTemplate(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting custom template",
+			"Could not delete custom template, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing template by ID, all attributes are filled by Read.
+func (r *customTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func fillStateWithCustomTemplateData(ctx context.Context, state *customTemplateResourceModel, customTemplate *psclient.CustomTemplate) diag.Diagnostics {
+	state.ID = types.StringValue(customTemplate.ID)
+	state.Name = types.StringValue(customTemplate.Name)
+	state.AgentType = types.StringValue(customTemplate.AgentType)
+	state.OperatingSystemLabel = types.StringValue(customTemplate.OperatingSystemLabel)
+	state.Region = types.StringValue(customTemplate.Region)
+	state.DefaultSizeGb = types.Int64Value(customTemplate.DefaultSizeGb)
+	state.DtCreated = types.StringValue(customTemplate.DtCreated)
+
+	// Keep configured machine ID if API doesn't report the parent machine, otherwise fill it, e.g. after import
+	if customTemplate.ParentMachineID != "" {
+		state.MachineID = types.StringValue(customTemplate.ParentMachineID)
+	}
+
+	availableMachineTypes := []availableMachineTypeModel{}
+	for _, item := range customTemplate.AvailableMachineTypes {
+		availableMachineTypes = append(availableMachineTypes, availableMachineTypeModel{
+			MachineTypeLabel: types.StringValue(item.MachineTypeLabel),
+			IsAvailable:      types.BoolValue(item.IsAvailable),
+		})
+	}
+
+	listValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: availableMachineTypeAttrTypes}, availableMachineTypes)
+	state.AvailableMachineTypes = listValue
+
+	return diags
+}
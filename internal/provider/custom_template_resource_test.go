@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccCustomTemplateResourceName = "paperspace_custom_template.test"
+
+// Machine is stopped by default, so template creation does not change its state
+const testAccCustomTemplateMachineConfig = `
+resource "paperspace_machine" "test_template_parent" {
+  name         = "paperspace-provider-test-CustomTemplateParent"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+}
+`
+
+var testAccCustomTemplateResourceConfigs = map[string]string{
+	"CreateRead": providerConfig + testAccCustomTemplateMachineConfig + `
+resource "paperspace_custom_template" "test" {
+  name       = "paperspace-provider-test-CreateRead"
+  machine_id = paperspace_machine.test_template_parent.id
+}
+`,
+
+	"UpdateRead": providerConfig + testAccCustomTemplateMachineConfig + `
+resource "paperspace_custom_template" "test" {
+  name       = "paperspace-provider-test-UpdateRead"
+  machine_id = paperspace_machine.test_template_parent.id
+}
+`,
+}
+
+var testAccCustomTemplateResourceSteps = []resource.TestStep{
+	{
+		Config: testAccCustomTemplateResourceConfigs["CreateRead"],
+		Check: resource.ComposeAggregateTestCheckFunc(append(genTestCheckFuncs(
+			testAccCustomTemplateResourceName,
+			map[string]string{
+				"name": "paperspace-provider-test-CreateRead",
+
+				"id":                        "_any_",
+				"region":                    "_any_",
+				"default_size_gb":           "_any_",
+				"dt_created":                "_any_",
+				"available_machine_types.#": "_any_",
+			},
+		),
+			resource.TestCheckResourceAttrPair(testAccCustomTemplateResourceName, "machine_id", "paperspace_machine.test_template_parent", "id"),
+		)...),
+	},
+	// ImportState testing
+	{
+		ResourceName:      testAccCustomTemplateResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	},
+	// Update and Read testing, the template must not be replaced on rename
+	{
+		Config: testAccCustomTemplateResourceConfigs["UpdateRead"],
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(testAccCustomTemplateResourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccCustomTemplateResourceName,
+			map[string]string{
+				"name": "paperspace-provider-test-UpdateRead",
+			},
+		)...),
+	},
+}
+
+func TestAccCustomTemplateResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccCustomTemplateResourceSteps,
+	})
+}
+
+// Same steps against the fake API, runs offline.
+func TestCustomTemplateResource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccCustomTemplateResourceSteps),
+	})
+}
+
+// Template is usable even if its running machine can't be started again afterwards,
+// so it's saved to state with a warning instead of being tainted.
+func TestCustomTemplateResourceStartError(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	config := psclient.MachineCreateConfig{
+		Name:          "paperspace-provider-test-CustomTemplateParent",
+		MachineType:   "C2",
+		TemplateID:    "t0nspur5",
+		DiskSize:      50,
+		Region:        "ny2",
+		StartOnCreate: true,
+	}
+
+	machine, err := fake.NewClient(t).CreateMachine(context.Background(), config)
+	if err != nil {
+		t.Fatalf("CreateMachine: %s", err)
+	}
+
+	templateConfig := testFakeProviderConfig(fake) + fmt.Sprintf(`
+resource "paperspace_custom_template" "test" {
+  name       = "paperspace-provider-test-StartError"
+  machine_id = %q
+}
+`, machine.ID)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					fake.FailRequests(http.MethodPatch, "/machines/"+machine.ID+"/start", http.StatusBadRequest, 1)
+				},
+				Config: templateConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(testAccCustomTemplateResourceName, "id"),
+					func(*terraform.State) error {
+						if state := fake.Machine(machine.ID).State; state != psclient.MachineStateOff {
+							return fmt.Errorf("expected machine state %s, got %s", psclient.MachineStateOff, state)
+						}
+						return nil
+					},
+				),
+			},
+			// Template is not tainted, so it's not created again
+			{
+				Config: templateConfig,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -3,11 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"terraform-provider-paperspace/internal/psclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -54,66 +56,132 @@ type customTemplatesModel struct {
 
 // customTemplatesDataSourceModel maps the data source schema data.
 type customTemplatesDataSourceModel struct {
+	// Filters
+	Name                 types.String `tfsdk:"name"`
+	NameRegex            types.String `tfsdk:"name_regex"`
+	Region               types.String `tfsdk:"region"`
+	AgentType            types.String `tfsdk:"agent_type"`
+	OperatingSystemLabel types.String `tfsdk:"operating_system_label"`
+	MachineType          types.String `tfsdk:"machine_type"`
+	ParentMachineID      types.String `tfsdk:"parent_machine_id"`
+
 	CustomTemplates []customTemplatesModel `tfsdk:"custom_templates"`
 }
 
+// customTemplateFilter holds configured filters, empty values match any template.
+type customTemplateFilter struct {
+	ID                   string
+	Name                 string
+	NameRegex            *regexp.Regexp
+	Region               string
+	AgentType            string
+	OperatingSystemLabel string
+	MachineType          string
+	ParentMachineID      string
+}
+
 //// Schema
 
 // Schema defines the schema for the data source.
 // The data source uses the Schema method to define the acceptable configuration and state attribute names and types.
 func (d *customTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := customTemplateFilterAttributes()
+	attributes["custom_templates"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Found custom templates, sorted by creation date from oldest to newest.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: customTemplateDataSourceAttributes(),
+		},
+	}
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"custom_templates": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "Template ID.",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "Template name.",
-							Computed:            true,
-						},
-						"agent_type": schema.StringAttribute{
-							MarkdownDescription: "Template agent type.",
-							Computed:            true,
-						},
-						"operating_system_label": schema.StringAttribute{
-							MarkdownDescription: "Template operating system label.",
-							Computed:            true,
-						},
-						"region": schema.StringAttribute{
-							MarkdownDescription: "Template region.",
-							Computed:            true,
-						},
-						"default_size_gb": schema.Int64Attribute{
-							MarkdownDescription: "Default disk size in GB.",
-							Computed:            true,
-						},
-						"available_machine_type": schema.ListNestedAttribute{
-							MarkdownDescription: "Available machine types.",
-							Computed:            true,
-							NestedObject: schema.NestedAttributeObject{
-								Attributes: map[string]schema.Attribute{
-									"machine_type_label": schema.StringAttribute{Computed: true},
-									"is_available":       schema.BoolAttribute{Computed: true},
-								},
-							},
-						},
-						"parent_machine_id": schema.StringAttribute{
-							MarkdownDescription: "Template parent machine ID.",
-							Computed:            true,
-						},
-						"dt_created": schema.StringAttribute{
-							MarkdownDescription: "Template created date timestamp.",
-							Computed:            true,
-						},
-					},
+		MarkdownDescription: "Custom templates of the team, optionally filtered. All filters must match.",
+		Attributes:          attributes,
+	}
+}
+
+// Returns optional filter arguments shared by custom template data sources.
+func customTemplateFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Match templates with this exact name.",
+			Optional:            true,
+		},
+		"name_regex": schema.StringAttribute{
+			MarkdownDescription: "Match templates with name matching this regular expression.",
+			Optional:            true,
+			Validators: []validator.String{
+				isRegex(),
+			},
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Match templates in this region, e.g. `ny2`.",
+			Optional:            true,
+		},
+		"agent_type": schema.StringAttribute{
+			MarkdownDescription: "Match templates with this agent type, e.g. `LinuxHeadless`.",
+			Optional:            true,
+		},
+		"operating_system_label": schema.StringAttribute{
+			MarkdownDescription: "Match templates with this operating system label.",
+			Optional:            true,
+		},
+		"machine_type": schema.StringAttribute{
+			MarkdownDescription: "Match templates where this machine type is available, e.g. `C5`.",
+			Optional:            true,
+		},
+		"parent_machine_id": schema.StringAttribute{
+			MarkdownDescription: "Match templates created from this machine.",
+			Optional:            true,
+		},
+	}
+}
+
+// Returns computed attributes of a single custom template.
+func customTemplateDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Template ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Template name.",
+			Computed:            true,
+		},
+		"agent_type": schema.StringAttribute{
+			MarkdownDescription: "Template agent type.",
+			Computed:            true,
+		},
+		"operating_system_label": schema.StringAttribute{
+			MarkdownDescription: "Template operating system label.",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Template region.",
+			Computed:            true,
+		},
+		"default_size_gb": schema.Int64Attribute{
+			MarkdownDescription: "Default disk size in GB.",
+			Computed:            true,
+		},
+		"available_machine_type": schema.ListNestedAttribute{
+			MarkdownDescription: "Available machine types.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"machine_type_label": schema.StringAttribute{Computed: true},
+					"is_available":       schema.BoolAttribute{Computed: true},
 				},
 			},
 		},
+		"parent_machine_id": schema.StringAttribute{
+			MarkdownDescription: "Template parent machine ID.",
+			Computed:            true,
+		},
+		"dt_created": schema.StringAttribute{
+			MarkdownDescription: "Template created date timestamp.",
+			Computed:            true,
+		},
 	}
 }
 
@@ -121,7 +189,22 @@ func (d *customTemplatesDataSource) Schema(_ context.Context, _ datasource.Schem
 func (d *customTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state customTemplatesDataSourceModel
 
-	customTemplates, err := d.client.GetCustomTemplates()
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := customTemplateFilter{
+		Name:                 state.Name.ValueString(),
+		NameRegex:            compileRegex(state.NameRegex),
+		Region:               state.Region.ValueString(),
+		AgentType:            state.AgentType.ValueString(),
+		OperatingSystemLabel: state.OperatingSystemLabel.ValueString(),
+		MachineType:          state.MachineType.ValueString(),
+		ParentMachineID:      state.ParentMachineID.ValueString(),
+	}
+
+	customTemplates, err := d.client.GetCustomTemplates(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read Paperspace CustomTemplates",
@@ -131,26 +214,9 @@ func (d *customTemplatesDataSource) Read(ctx context.Context, req datasource.Rea
 	}
 
 	// Map response body to model
-	for _, customTemplate := range *customTemplates {
-		customTemplateState := customTemplatesModel{
-			ID:                   types.StringValue(customTemplate.ID),
-			Name:                 types.StringValue(customTemplate.Name),
-			AgentType:            types.StringValue(customTemplate.AgentType),
-			OperatingSystemLabel: types.StringValue(customTemplate.OperatingSystemLabel),
-			Region:               types.StringValue(customTemplate.Region),
-			DefaultSizeGb:        types.Int64Value(customTemplate.DefaultSizeGb),
-			ParentMachineID:      types.StringValue(customTemplate.ParentMachineID),
-			DtCreated:            types.StringValue(customTemplate.DtCreated),
-		}
-
-		for _, item := range customTemplate.AvailableMachineTypes {
-			customTemplateState.AvailableMachineType = append(customTemplateState.AvailableMachineType, availableMachineTypeModel{
-				MachineTypeLabel: types.StringValue(item.MachineTypeLabel),
-				IsAvailable:      types.BoolValue(item.IsAvailable),
-			})
-		}
-
-		state.CustomTemplates = append(state.CustomTemplates, customTemplateState)
+	state.CustomTemplates = []customTemplatesModel{}
+	for _, customTemplate := range filter.apply(*customTemplates) {
+		state.CustomTemplates = append(state.CustomTemplates, newCustomTemplateModel(&customTemplate))
 	}
 
 	// Set state
@@ -182,3 +248,84 @@ func (d *customTemplatesDataSource) Configure(_ context.Context, req datasource.
 
 	d.client = client
 }
+
+// Returns templates matching all filters, keeping their order.
+func (f customTemplateFilter) apply(customTemplates []psclient.CustomTemplate) []psclient.CustomTemplate {
+	filtered := []psclient.CustomTemplate{}
+
+	for _, customTemplate := range customTemplates {
+		if f.matches(&customTemplate) {
+			filtered = append(filtered, customTemplate)
+		}
+	}
+
+	return filtered
+}
+
+func (f customTemplateFilter) matches(customTemplate *psclient.CustomTemplate) bool {
+	switch {
+	case f.ID != "" && customTemplate.ID != f.ID:
+		return false
+	case f.Name != "" && customTemplate.Name != f.Name:
+		return false
+	case f.NameRegex != nil && !f.NameRegex.MatchString(customTemplate.Name):
+		return false
+	case f.Region != "" && normalizeRegion(customTemplate.Region) != normalizeRegion(f.Region):
+		return false
+	case f.AgentType != "" && customTemplate.AgentType != f.AgentType:
+		return false
+	case f.OperatingSystemLabel != "" && customTemplate.OperatingSystemLabel != f.OperatingSystemLabel:
+		return false
+	case f.ParentMachineID != "" && customTemplate.ParentMachineID != f.ParentMachineID:
+		return false
+	case f.MachineType != "" && !isMachineTypeAvailable(customTemplate, f.MachineType):
+		return false
+	}
+
+	return true
+}
+
+func isMachineTypeAvailable(customTemplate *psclient.CustomTemplate, machineType string) bool {
+	for _, item := range customTemplate.AvailableMachineTypes {
+		if item.MachineTypeLabel == machineType && item.IsAvailable {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns compiled regular expression or nil if the value is not set.
+// The value is checked by isRegex validator, so compile errors are not expected here.
+func compileRegex(value types.String) *regexp.Regexp {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	re, err := regexp.Compile(value.ValueString())
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+func newCustomTemplateModel(customTemplate *psclient.CustomTemplate) customTemplatesModel {
+	customTemplateState := customTemplatesModel{
+		ID:                   types.StringValue(customTemplate.ID),
+		Name:                 types.StringValue(customTemplate.Name),
+		AgentType:            types.StringValue(customTemplate.AgentType),
+		OperatingSystemLabel: types.StringValue(customTemplate.OperatingSystemLabel),
+		Region:               types.StringValue(customTemplate.Region),
+		DefaultSizeGb:        types.Int64Value(customTemplate.DefaultSizeGb),
+		ParentMachineID:      types.StringValue(customTemplate.ParentMachineID),
+		DtCreated:            types.StringValue(customTemplate.DtCreated),
+	}
+
+	for _, item := range customTemplate.AvailableMachineTypes {
+		customTemplateState.AvailableMachineType = append(customTemplateState.AvailableMachineType, availableMachineTypeModel{
+			MachineTypeLabel: types.StringValue(item.MachineTypeLabel),
+			IsAvailable:      types.BoolValue(item.IsAvailable),
+		})
+	}
+
+	return customTemplateState
+}
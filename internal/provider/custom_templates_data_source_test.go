@@ -9,7 +9,7 @@ import (
 func TestAccCustomTemplatesDataSource(t *testing.T) {
 	// TODO: Create real test
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
 		Steps: []resource.TestStep{
 			// Read testing
 			{
@@ -27,6 +27,18 @@ func TestAccCustomTemplatesDataSource(t *testing.T) {
 				// resource.TestCheckResourceAttr("data.paperspace_custom_templates.test", "custom_templates.0.nested_attr.0.id", "exampleid"),
 				),
 			},
+			// Filtered read testing, no template can match the impossible name
+			{
+				Config: providerConfig + `
+data "paperspace_custom_templates" "test" {
+  name_regex = "^paperspace-provider-test-missing-template$"
+  region     = "ny2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_custom_templates.test", "custom_templates.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// eventPolicyModel maps the event_policy schema data of the provider and resources.
+type eventPolicyModel struct {
+	WaitFor              types.List  `tfsdk:"wait_for"`
+	Ignore               types.List  `tfsdk:"ignore"`
+	NullErrorAsSuccess   types.Bool  `tfsdk:"null_error_as_success"`
+	MaxConsecutiveErrors types.Int64 `tfsdk:"max_consecutive_errors"`
+}
+
+// Attribute descriptions shared by the provider and resource schemas.
+const (
+	eventPolicyDescription             = "Controls waiting for machine events, e.g. to skip a stale unrelated event which would block or fail the operation."
+	eventPolicyWaitForDescription      = "Names of machine events to wait for, e.g. `[\"start\", \"stop\"]`. All pending events are waited for if not set."
+	eventPolicyIgnoreDescription       = "Names of machine events which are never waited for, e.g. `[\"snapshot\"]`. Takes precedence over `wait_for`."
+	eventPolicyNullErrorDescription    = "Whether to treat events in `error` state without error details as completed. Defaults to `false`."
+	eventPolicyMaxConsecutiveErrorsDoc = "Number of consecutive checks of an event in `error` state without error details, after which the event is considered failed. Defaults to `1`."
+)
+
+func eventPolicyProviderAttribute() providerschema.SingleNestedAttribute {
+	return providerschema.SingleNestedAttribute{
+		MarkdownDescription: eventPolicyDescription + " May be overridden by resources.",
+		Optional:            true,
+		Attributes: map[string]providerschema.Attribute{
+			"wait_for": providerschema.ListAttribute{
+				MarkdownDescription: eventPolicyWaitForDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ignore": providerschema.ListAttribute{
+				MarkdownDescription: eventPolicyIgnoreDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"null_error_as_success": providerschema.BoolAttribute{
+				MarkdownDescription: eventPolicyNullErrorDescription,
+				Optional:            true,
+			},
+			"max_consecutive_errors": providerschema.Int64Attribute{
+				MarkdownDescription: eventPolicyMaxConsecutiveErrorsDoc,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func eventPolicyResourceAttribute() resourceschema.SingleNestedAttribute {
+	return resourceschema.SingleNestedAttribute{
+		MarkdownDescription: eventPolicyDescription + " Overrides the provider `event_policy` attributes which are set.",
+		Optional:            true,
+		Attributes: map[string]resourceschema.Attribute{
+			"wait_for": resourceschema.ListAttribute{
+				MarkdownDescription: eventPolicyWaitForDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"ignore": resourceschema.ListAttribute{
+				MarkdownDescription: eventPolicyIgnoreDescription,
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"null_error_as_success": resourceschema.BoolAttribute{
+				MarkdownDescription: eventPolicyNullErrorDescription,
+				Optional:            true,
+			},
+			"max_consecutive_errors": resourceschema.Int64Attribute{
+				MarkdownDescription: eventPolicyMaxConsecutiveErrorsDoc,
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// Returns the base policy with attributes which are set in the model, unset ones keep base values.
+func (m *eventPolicyModel) apply(ctx context.Context, base psclient.EventPolicy) (psclient.EventPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if m == nil {
+		return base, diags
+	}
+
+	policy := base
+
+	if !m.WaitFor.IsNull() && !m.WaitFor.IsUnknown() {
+		policy.WaitFor = []string{}
+		diags.Append(m.WaitFor.ElementsAs(ctx, &policy.WaitFor, false)...)
+	}
+
+	if !m.Ignore.IsNull() && !m.Ignore.IsUnknown() {
+		policy.Ignore = []string{}
+		diags.Append(m.Ignore.ElementsAs(ctx, &policy.Ignore, false)...)
+	}
+
+	if !m.NullErrorAsSuccess.IsNull() && !m.NullErrorAsSuccess.IsUnknown() {
+		policy.NullErrorAsSuccess = m.NullErrorAsSuccess.ValueBool()
+	}
+
+	if !m.MaxConsecutiveErrors.IsNull() && !m.MaxConsecutiveErrors.IsUnknown() {
+		policy.MaxConsecutiveErrors = int(m.MaxConsecutiveErrors.ValueInt64())
+	}
+
+	return policy, diags
+}
+
+// Returns context with the event policy of the resource operation, based on the provider level policy.
+func withEventPolicy(ctx context.Context, client *psclient.Client, model *eventPolicyModel) (context.Context, diag.Diagnostics) {
+	policy, diags := model.apply(ctx, client.EventPolicy)
+	return psclient.WithEventPolicy(ctx, policy), diags
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &machineAvailabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &machineAvailabilityDataSource{}
+)
+
+// NewMachineAvailabilityDataSource is a helper function to simplify the provider implementation.
+func NewMachineAvailabilityDataSource() datasource.DataSource {
+	return &machineAvailabilityDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type machineAvailabilityDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *machineAvailabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_availability"
+}
+
+//// Data model types
+
+// machineAvailabilityDataSourceModel maps the data source schema data.
+type machineAvailabilityDataSourceModel struct {
+	Region      types.String `tfsdk:"region"`
+	MachineType types.String `tfsdk:"machine_type"`
+	Available   types.Bool   `tfsdk:"available"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// The data source uses the Schema method to define the acceptable configuration and state attribute names and types.
+func (d *machineAvailabilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks whether machines of the given type can be created in the region right now.",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to check, e.g. `ny2`.",
+				Required:            true,
+			},
+			"machine_type": schema.StringAttribute{
+				MarkdownDescription: "The machine type to check, e.g. `A4000`.",
+				Required:            true,
+			},
+			"available": schema.BoolAttribute{
+				MarkdownDescription: "Whether the machine type is currently available in the region.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *machineAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state machineAvailabilityDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	availability, err := d.client.GetMachineAvailability(ctx, state.Region.ValueString(), state.MachineType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace Machine Availability",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Available = types.BoolValue(availability.Available)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *machineAvailabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
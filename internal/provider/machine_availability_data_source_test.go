@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMachineAvailabilityDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Read testing, availability changes over time, so only check it's set
+			{
+				Config: providerConfig + `
+data "paperspace_machine_availability" "test" {
+  region       = "ny2"
+  machine_type = "C2"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_machine_availability.test", "region", "ny2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_availability.test", "machine_type", "C2"),
+					resource.TestCheckResourceAttrSet("data.paperspace_machine_availability.test", "available"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &machineDataSource{}
+	_ datasource.DataSourceWithConfigure = &machineDataSource{}
+)
+
+// NewMachineDataSource is a helper function to simplify the provider implementation.
+func NewMachineDataSource() datasource.DataSource {
+	return &machineDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type machineDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *machineDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine"
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// Lookup arguments are the same attributes which are filled with the found machine data.
+func (d *machineDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := machineDataSourceAttributes()
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the machine to look up. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the machine to look up. The name must be unique within the team.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single machine by ID or name, e.g. a machine managed in another Terraform state.",
+		Attributes:          attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *machineDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config machineModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var machine *psclient.Machine
+
+	if !config.ID.IsNull() {
+		var err error
+		machine, err = d.client.GetMachine(ctx, config.ID.ValueString())
+		if err != nil {
+			if psclient.IsNotFound(err) {
+				resp.Diagnostics.AddError(
+					"Machine Not Found",
+					fmt.Sprintf("Machine with ID %s does not exist.", config.ID.ValueString()),
+				)
+				return
+			}
+
+			resp.Diagnostics.AddError(
+				"Unable to Read Paperspace Machine",
+				err.Error(),
+			)
+			return
+		}
+	} else {
+		machines, err := d.client.GetMachines(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Paperspace Machines",
+				err.Error(),
+			)
+			return
+		}
+
+		found := machineFilter{Name: config.Name.ValueString()}.apply(*machines)
+		if len(found) != 1 {
+			resp.Diagnostics.AddError(
+				"Unable to Find Single Machine",
+				fmt.Sprintf("Expected exactly one machine with name %q, found %d. Use id to look up machines with non-unique names.", config.Name.ValueString(), len(found)),
+			)
+			return
+		}
+
+		machine = &found[0]
+	}
+
+	state := newMachineModel(machine)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *machineDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
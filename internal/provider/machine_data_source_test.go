@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMachineDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Lookup by ID and by name must find the same machine
+			{
+				Config: providerConfig + `
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-MachineDataSource"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+}
+
+data "paperspace_machine" "by_id" {
+  id = paperspace_machine.test.id
+}
+
+data "paperspace_machine" "by_name" {
+  name = paperspace_machine.test.name
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.paperspace_machine.by_id", "name", "paperspace_machine.test", "name"),
+					resource.TestCheckResourceAttrPair("data.paperspace_machine.by_id", "private_ip", "paperspace_machine.test", "private_ip"),
+					resource.TestCheckResourceAttr("data.paperspace_machine.by_id", "region", "ny2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine.by_id", "machine_type", "C2"),
+					resource.TestCheckNoResourceAttr("data.paperspace_machine.by_id", "reservation"),
+					resource.TestCheckResourceAttrPair("data.paperspace_machine.by_name", "id", "paperspace_machine.test", "id"),
+					resource.TestCheckResourceAttrPair("data.paperspace_machine.by_name", "public_ip", "paperspace_machine.test", "public_ip"),
+				),
+			},
+		},
+	})
+}
+
+// Reservation without finish time has null dt_finished instead of the zero time.
+func TestMachineDataSourceReservation(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	machineID := fake.AddMachine(psclient.Machine{
+		Name:         "reserved",
+		State:        psclient.MachineStateOff,
+		MachineType:  "C2",
+		RegionFull:   "East Coast (NY2)",
+		NetworkID:    "psn-default-ny2",
+		PublicIPType: "dynamic",
+		Reservation: &psclient.Reservation{
+			ID:        "psr0001",
+			Name:      "reserved",
+			DtStarted: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			IsActive:  true,
+		},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(fake) + `
+data "paperspace_machine" "test" {
+  id = "` + machineID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_machine.test", "reservation.id", "psr0001"),
+					resource.TestCheckResourceAttr("data.paperspace_machine.test", "reservation.dt_started", "2024-01-01T00:00:00Z"),
+					resource.TestCheckNoResourceAttr("data.paperspace_machine.test", "reservation.dt_finished"),
+				),
+			},
+		},
+	})
+}
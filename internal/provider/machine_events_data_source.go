@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &machineEventsDataSource{}
+	_ datasource.DataSourceWithConfigure = &machineEventsDataSource{}
+)
+
+// NewMachineEventsDataSource is a helper function to simplify the provider implementation.
+func NewMachineEventsDataSource() datasource.DataSource {
+	return &machineEventsDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type machineEventsDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *machineEventsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machine_events"
+}
+
+//// Data model types
+
+// machineEventModel maps machine event schema data.
+type machineEventModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	State      types.String `tfsdk:"state"`
+	MachineID  types.String `tfsdk:"machine_id"`
+	Error      types.String `tfsdk:"error"`
+	DtCreated  types.String `tfsdk:"dt_created"`
+	DtStarted  types.String `tfsdk:"dt_started"`
+	DtFinished types.String `tfsdk:"dt_finished"`
+}
+
+// machineEventsDataSourceModel maps the data source schema data.
+type machineEventsDataSourceModel struct {
+	// Filters
+	MachineID types.String `tfsdk:"machine_id"`
+	Name      types.String `tfsdk:"name"`
+	State     types.String `tfsdk:"state"`
+	MaxAge    types.String `tfsdk:"max_age"`
+
+	Events []machineEventModel `tfsdk:"events"`
+
+	// Aggregates of found events
+	Total       types.Int64      `tfsdk:"total"`
+	NameCounts  map[string]int64 `tfsdk:"name_counts"`
+	StateCounts map[string]int64 `tfsdk:"state_counts"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// The data source uses the Schema method to define the acceptable configuration and state attribute names and types.
+func (d *machineEventsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Machine events of the team, optionally filtered, with aggregate counts of the found events.",
+		Attributes: map[string]schema.Attribute{
+			"machine_id": schema.StringAttribute{
+				MarkdownDescription: "Return only events of this machine.",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Return only events with this name, e.g. `start`.",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Return only events in this state, e.g. `error`.",
+				Optional:            true,
+			},
+			"max_age": schema.StringAttribute{
+				MarkdownDescription: "Return only events created at most this long ago, e.g. `1h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"events": schema.ListNestedAttribute{
+				MarkdownDescription: "Found machine events.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Event ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Event name, e.g. `create` or `start`.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Event state, e.g. `done` or `error`.",
+							Computed:            true,
+						},
+						"machine_id": schema.StringAttribute{
+							MarkdownDescription: "Event machine ID.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "Event error message, if any.",
+							Computed:            true,
+						},
+						"dt_created": schema.StringAttribute{
+							MarkdownDescription: "Event created date timestamp.",
+							Computed:            true,
+						},
+						"dt_started": schema.StringAttribute{
+							MarkdownDescription: "Event started date timestamp.",
+							Computed:            true,
+						},
+						"dt_finished": schema.StringAttribute{
+							MarkdownDescription: "Event finished date timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"total": schema.Int64Attribute{
+				MarkdownDescription: "Number of found events.",
+				Computed:            true,
+			},
+			"name_counts": schema.MapAttribute{
+				MarkdownDescription: "Number of found events by event name.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"state_counts": schema.MapAttribute{
+				MarkdownDescription: "Number of found events by event state.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *machineEventsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state machineEventsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Duration is checked by isDuration validator
+	now := time.Now()
+	var maxAge time.Duration
+	if !state.MaxAge.IsNull() {
+		maxAge, _ = time.ParseDuration(state.MaxAge.ValueString())
+	}
+
+	events, err := d.client.GetMachineEvents(ctx, state.MachineID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace Machine Events",
+			err.Error(),
+		)
+		return
+	}
+
+	found := []psclient.Event{}
+	for _, event := range *events {
+		if !state.Name.IsNull() && event.Name != state.Name.ValueString() {
+			continue
+		}
+
+		if !state.State.IsNull() && event.State != state.State.ValueString() {
+			continue
+		}
+
+		if maxAge > 0 {
+			dtCreated, err := time.Parse(time.RFC3339, event.DtCreated)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Filter Paperspace Machine Events",
+					fmt.Sprintf("Could not parse created date of event %s: %s", event.ID, err),
+				)
+				return
+			}
+
+			if now.Sub(dtCreated) > maxAge {
+				continue
+			}
+		}
+
+		found = append(found, event)
+	}
+
+	// Map response body to model
+	state.Events = []machineEventModel{}
+	state.StateCounts = map[string]int64{}
+	for _, event := range found {
+		state.Events = append(state.Events, machineEventModel{
+			ID:         types.StringValue(event.ID),
+			Name:       types.StringValue(event.Name),
+			State:      types.StringValue(event.State),
+			MachineID:  types.StringValue(event.MachineID),
+			Error:      types.StringPointerValue(event.Error), // Nullable field
+			DtCreated:  types.StringValue(event.DtCreated),
+			DtStarted:  types.StringPointerValue(event.DtStarted),  // Nullable field
+			DtFinished: types.StringPointerValue(event.DtFinished), // Nullable field
+		})
+
+		state.StateCounts[event.State]++
+	}
+
+	state.Total = types.Int64Value(int64(len(found)))
+	state.NameCounts = map[string]int64{}
+	for name, count := range psclient.MachineEventsStateStat(found) {
+		state.NameCounts[name] = int64(count)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *machineEventsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
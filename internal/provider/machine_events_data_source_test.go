@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMachineEventsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Read testing, aggregates must match the found events
+			{
+				Config: providerConfig + `
+data "paperspace_machine_events" "test" {
+  max_age = "24h"
+}
+
+data "paperspace_machine_events" "failed" {
+  state   = "error"
+  max_age = "24h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.paperspace_machine_events.test", "total", "data.paperspace_machine_events.test", "events.#"),
+					resource.TestCheckResourceAttrPair("data.paperspace_machine_events.failed", "total", "data.paperspace_machine_events.failed", "events.#"),
+					resource.TestCheckNoResourceAttr("data.paperspace_machine_events.failed", "state_counts.done"),
+				),
+			},
+		},
+	})
+}
+
+// Filters and aggregates run against scripted events of two machines.
+func TestMachineEventsDataSourceFilters(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	now := time.Now().UTC()
+	addEvent := func(machineID string, name string, state string, errorMessage string, age time.Duration) string {
+		dtCreated := now.Add(-age).Format(time.RFC3339)
+		event := psclient.Event{Name: name, State: state, MachineID: machineID, DtCreated: dtCreated, DtStarted: &dtCreated}
+		if errorMessage != "" {
+			event.Error = &errorMessage
+		}
+		if state != "in progress" {
+			event.DtFinished = &dtCreated
+		}
+		return fake.AddMachineEvent(event)
+	}
+
+	addEvent("psm0001", "create", "done", "", 2*time.Hour)
+	failedStart := addEvent("psm0001", "start", "error", "out of capacity", time.Hour)
+	addEvent("psm0001", "start", "done", "", 30*time.Minute)
+	oldStop := addEvent("psm0001", "stop", "error", "timeout", 72*time.Hour)
+	addEvent("psm0002", "start", "done", "", 10*time.Minute)
+	pendingSnapshot := addEvent("psm0002", "snapshot", "in progress", "", 5*time.Minute)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(fake) + `
+data "paperspace_machine_events" "all" {}
+
+data "paperspace_machine_events" "machine" {
+  machine_id = "psm0001"
+  max_age    = "24h"
+}
+
+data "paperspace_machine_events" "name" {
+  name = "start"
+}
+
+data "paperspace_machine_events" "state" {
+  state = "error"
+}
+
+data "paperspace_machine_events" "pending" {
+  machine_id = "psm0002"
+  state      = "in progress"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "total", "6"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "events.#", "6"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "name_counts.%", "4"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "name_counts.create", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "name_counts.start", "3"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "name_counts.stop", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "name_counts.snapshot", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "state_counts.%", "3"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "state_counts.done", "3"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "state_counts.error", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.all", "state_counts.in progress", "1"),
+
+					// Old stop event and events of the other machine are filtered out
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "total", "3"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "name_counts.%", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "name_counts.create", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "name_counts.start", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "state_counts.%", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "state_counts.done", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.machine", "state_counts.error", "1"),
+
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.name", "total", "3"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.name", "name_counts.%", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.name", "name_counts.start", "3"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.name", "state_counts.%", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.name", "state_counts.done", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.name", "state_counts.error", "1"),
+
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "total", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "name_counts.%", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "name_counts.start", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "name_counts.stop", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "state_counts.%", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "state_counts.error", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "events.0.id", failedStart),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "events.0.error", "out of capacity"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.state", "events.1.id", oldStop),
+
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "total", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "name_counts.%", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "name_counts.snapshot", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "state_counts.%", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "state_counts.in progress", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "events.0.id", pendingSnapshot),
+					resource.TestCheckResourceAttr("data.paperspace_machine_events.pending", "events.0.machine_id", "psm0002"),
+					resource.TestCheckNoResourceAttr("data.paperspace_machine_events.pending", "events.0.dt_finished"),
+				),
+			},
+		},
+	})
+}
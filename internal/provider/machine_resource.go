@@ -4,11 +4,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"terraform-provider-paperspace/internal/psclient"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -17,17 +25,45 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &machineResource{}
-	_ resource.ResourceWithConfigure = &machineResource{}
+	_ resource.Resource                = &machineResource{}
+	_ resource.ResourceWithConfigure   = &machineResource{}
+	_ resource.ResourceWithImportState = &machineResource{}
+	_ resource.ResourceWithModifyPlan  = &machineResource{}
 )
 
+// Default operation timeouts, may be overridden with the timeouts block.
+const (
+	defaultMachineCreateTimeout = 45 * time.Minute
+	defaultMachineReadTimeout   = 5 * time.Minute
+	defaultMachineUpdateTimeout = 45 * time.Minute
+	defaultMachineDeleteTimeout = 15 * time.Minute
+)
+
+// Attribute types of accelerators list elements.
+var machineAcceleratorAttrTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"memory": types.StringType,
+	"count":  types.Int64Type,
+}
+
+// Attribute types of reservation object.
+var machineReservationAttrTypes = map[string]attr.Type{
+	"id":          types.StringType,
+	"name":        types.StringType,
+	"dt_started":  types.StringType,
+	"dt_finished": types.StringType,
+	"is_active":   types.BoolType,
+}
+
 // NewMachineResource is a helper function to simplify the provider implementation.
 func NewMachineResource() resource.Resource {
 	return &machineResource{}
@@ -57,7 +93,7 @@ type machineResourceModel struct {
 	TakeInitialSnapshot    types.Bool   `tfsdk:"take_initial_snapshot"`
 	StartupScriptID        types.String `tfsdk:"startup_script_id"`
 	EmailPassword          types.Bool   `tfsdk:"email_password"`
-	AccessorIDs            types.List   `tfsdk:"accessor_ids"`
+	AccessorIDs            types.Set    `tfsdk:"accessor_ids"`
 
 	// Computed only
 	ID           types.String  `tfsdk:"id"`
@@ -75,11 +111,12 @@ type machineResourceModel struct {
 	StorageRate  types.Float64 `tfsdk:"storage_rate"`
 	DtCreated    types.String  `tfsdk:"dt_created"`
 	DtModified   types.String  `tfsdk:"dt_modified"`
+	DtDeleted    types.String  `tfsdk:"dt_deleted"`
+	Accelerators types.List    `tfsdk:"accelerators"`
+	Reservation  types.Object  `tfsdk:"reservation"`
 
-	//// Note: These fields are omitted
-	// DtDeleted    types.String  `tfsdk:"dtDeleted"`
-	// Reservation  *Reservation  `tfsdk:"reservation"`
-	// Accelerators []Accelerator `tfsdk:"accelerators"`
+	EventPolicy *eventPolicyModel `tfsdk:"event_policy"`
+	Timeouts    timeouts.Value    `tfsdk:"timeouts"`
 }
 
 // machineResource is the resource implementation.
@@ -96,7 +133,7 @@ func (r *machineResource) Metadata(_ context.Context, req resource.MetadataReque
 // Define Schema
 // The resource uses the Schema method to define the supported configuration, plan, and state attribute names and types.
 // The machine resource will need to save a machine with various attributes to Terraform's state.
-func (r *machineResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *machineResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	// elementType: types.StringType, elements: []attr.Value{}
 
@@ -118,10 +155,10 @@ func (r *machineResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:            true,
 			},
 			"template_id": schema.StringAttribute{
-				MarkdownDescription: "The template ID.",
+				MarkdownDescription: "The template ID. Forces resource replacement if changed, except when it's set for the first time after import.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringRequiresReplaceUnlessImported(),
 				},
 			},
 			"disk_size": schema.Int64Attribute{
@@ -243,40 +280,43 @@ func (r *machineResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:      true,
 				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
-			// Restore Point (only computed, user input is not implemented yet)
+			// Restore Point, the snapshot ID is computed unless configured
 			"restore_point_enabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether to use initial snapshot as a restore point.",
+				MarkdownDescription: "Whether to restore the machine disk from the restore point snapshot. Requires `restore_point_frequency`.",
+				Optional:            true,
 				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
 			},
 			"restore_point_frequency": schema.StringAttribute{
-				MarkdownDescription: "The restore point frequency. Possible values: `shutdown`.",
-				Computed:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				MarkdownDescription: "The restore point frequency. Possible values: `shutdown`, i.e. the disk is restored on every shutdown.",
+				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf([]string{"shutdown"}...),
 				},
 			},
 			"restore_point_snapshot_id": schema.StringAttribute{
-				MarkdownDescription: "The restore point snapshot ID.",
+				MarkdownDescription: "The ID of the snapshot to restore the machine from. If not set, the initial snapshot is used, see `take_initial_snapshot`.",
+				Optional:            true,
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			// Attributes which apply only on creation
 			"enable_nvlink": schema.BoolAttribute{
-				MarkdownDescription: "Whether to enable NVLink.",
+				MarkdownDescription: "Whether to enable NVLink. Forces resource replacement if changed, except when it's set for the first time after import.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(), // Forces resource replacement if changed
+					boolRequiresReplaceUnlessImported(),
 				},
 				Computed: true,
 				Default:  booldefault.StaticBool(false),
 			},
 			"take_initial_snapshot": schema.BoolAttribute{
-				MarkdownDescription: "Whether to take an initial snapshot. Applies only on resource creation.",
+				MarkdownDescription: "Whether to take an initial snapshot. Applies only on resource creation, later changes are ignored.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
+				PlanModifiers:       []planmodifier.Bool{createOnly()},
 			},
 			"startup_script_id": schema.StringAttribute{
 				MarkdownDescription: "The startup script ID. Forces resource replacement if changed.",
@@ -286,10 +326,11 @@ func (r *machineResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"email_password": schema.BoolAttribute{
-				MarkdownDescription: "Whether to email the password. Applies only on resource creation.",
+				MarkdownDescription: "Whether to email the password. Applies only on resource creation, later changes are ignored.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
+				PlanModifiers:       []planmodifier.Bool{createOnly()},
 			},
 			// Computed only
 			"ram": schema.StringAttribute{
@@ -321,17 +362,76 @@ func (r *machineResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "Modified date timestamp of the machine.",
 				Computed:            true,
 			},
-			// TODO: Implement change on resource update, so machine accessors are being updated
-			"accessor_ids": schema.ListAttribute{
-				MarkdownDescription: "The IDs of users to grant access to the machine. Applies only on resource creation.",
-				Optional:            true,
-				ElementType:         types.StringType,
+			"dt_deleted": schema.StringAttribute{
+				MarkdownDescription: "Deleted date timestamp of the machine.",
+				Computed:            true,
+			},
+			"accelerators": schema.ListNestedAttribute{
+				MarkdownDescription: "Accelerators (GPUs) of the machine. Changes with `machine_type`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Accelerator name.",
+							Computed:            true,
+						},
+						"memory": schema.StringAttribute{
+							MarkdownDescription: "Accelerator memory.",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "Number of accelerators.",
+							Computed:            true,
+						},
+					},
+				},
 			},
+			"reservation": schema.SingleNestedAttribute{
+				MarkdownDescription: "Machine reservation, null if the machine is not reserved.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Reservation ID.",
+						Computed:            true,
+					},
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Reservation name.",
+						Computed:            true,
+					},
+					"dt_started": schema.StringAttribute{
+						MarkdownDescription: "Reservation started date timestamp.",
+						Computed:            true,
+					},
+					"dt_finished": schema.StringAttribute{
+						MarkdownDescription: "Reservation finished date timestamp.",
+						Computed:            true,
+					},
+					"is_active": schema.BoolAttribute{
+						MarkdownDescription: "Whether the reservation is active.",
+						Computed:            true,
+					},
+				},
+			},
+			"accessor_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of users to grant access to the machine. " +
+					"If set, accessors added or removed outside of Terraform are reported as drift. " +
+					"If omitted, machine accessors are not managed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"event_policy": eventPolicyResourceAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
-// TODO: Add timeouts https://developer.hashicorp.com/terraform/plugin/framework/resources/timeouts
 // Create a new resource.
 func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan machineResourceModel
@@ -343,46 +443,64 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// Generate API request body from plan and create new machine
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultMachineCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	accessors := []string{}
-	for _, item := range plan.AccessorIDs.Elements() {
-		if strValue, ok := item.(types.String); ok {
-			accessors = append(accessors, strValue.ValueString()) // Extract the string value
-		}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ctx, diags = withEventPolicy(ctx, r.client, plan.EventPolicy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	// Generate API request body from plan and create new machine
+
 	reqData := psclient.MachineCreateConfig{
-		Name:                  plan.Name.ValueString(),        // required
-		MachineType:           plan.MachineType.ValueString(), // required
-		TemplateID:            plan.TemplateID.ValueString(),  // required
-		DiskSize:              plan.DiskSize.ValueInt64(),     // required
-		Region:                plan.Region.ValueString(),      // required
-		NetworkID:             plan.PrivateNetworkID.ValueString(),
-		PublicIPType:          plan.PublicIPType.ValueString(),
-		StartOnCreate:         plan.State.ValueString() == "ready",
-		AutoSnapshotEnabled:   getValueBoolPointer(plan.AutoSnapshotEnabled),
-		AutoSnapshotFrequency: plan.AutoSnapshotFrequency.ValueString(),
-		AutoSnapshotSaveCount: getValueInt64Pointer(plan.AutoSnapshotSaveCount),
-		AutoShutdownEnabled:   getValueBoolPointer(plan.AutoShutdownEnabled),
-		AutoShutdownTimeout:   getValueInt64Pointer(plan.AutoShutdownTimeout),
-		AutoShutdownForce:     getValueBoolPointer(plan.AutoShutdownForce),
-		EnableNvlink:          getValueBoolPointer(plan.EnableNvlink),
-		TakeInitialSnapshot:   getValueBoolPointer(plan.TakeInitialSnapshot),
-		StartupScriptID:       plan.StartupScriptID.ValueString(),
-		EmailPassword:         getValueBoolPointer(plan.EmailPassword),
-		AccessorIDs:           accessors,
+		Name:                   plan.Name.ValueString(),        // required
+		MachineType:            plan.MachineType.ValueString(), // required
+		TemplateID:             plan.TemplateID.ValueString(),  // required
+		DiskSize:               plan.DiskSize.ValueInt64(),     // required
+		Region:                 plan.Region.ValueString(),      // required
+		NetworkID:              plan.PrivateNetworkID.ValueString(),
+		PublicIPType:           plan.PublicIPType.ValueString(),
+		StartOnCreate:          plan.State.ValueString() == "ready",
+		AutoSnapshotEnabled:    getValueBoolPointer(plan.AutoSnapshotEnabled),
+		AutoSnapshotFrequency:  plan.AutoSnapshotFrequency.ValueString(),
+		AutoSnapshotSaveCount:  getValueInt64Pointer(plan.AutoSnapshotSaveCount),
+		AutoShutdownEnabled:    getValueBoolPointer(plan.AutoShutdownEnabled),
+		AutoShutdownTimeout:    getValueInt64Pointer(plan.AutoShutdownTimeout),
+		AutoShutdownForce:      getValueBoolPointer(plan.AutoShutdownForce),
+		RestorePointEnabled:    getValueBoolPointer(plan.RestorePointEnabled),
+		RestorePointFrequency:  plan.RestorePointFrequency.ValueString(),
+		RestorePointSnapshotID: plan.RestorePointSnapshotID.ValueString(),
+		EnableNvlink:           getValueBoolPointer(plan.EnableNvlink),
+		TakeInitialSnapshot:    getValueBoolPointer(plan.TakeInitialSnapshot),
+		StartupScriptID:        plan.StartupScriptID.ValueString(),
+		EmailPassword:          getValueBoolPointer(plan.EmailPassword),
+		AccessorIDs:            getStringElements(plan.AccessorIDs),
 	}
 
 	jsonData, _ := json.MarshalIndent(reqData, "", " ")
 	tflog.Info(ctx, "Sending create req data: "+string(jsonData))
 
-	machine, err := r.client.CreateMachine(reqData)
+	machine, err := r.client.CreateMachine(ctx, reqData)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating machine",
 			"Could not create machine, unexpected error: "+err.Error(),
 		)
+
+		// Machine is created, but could not become ready. Save it to state, so Terraform marks it as tainted
+		// and destroys or replaces it on the next apply, instead of losing track of a billable machine.
+		if machine != nil && machine.ID != "" {
+			tflog.Warn(ctx, "Saving partially created machine to state, id "+machine.ID)
+			resp.Diagnostics.Append(r.setPartiallyCreatedMachineState(ctx, &resp.State, plan, machine)...)
+		}
 		return
 	}
 	tflog.Info(ctx, "Created a resource with id "+machine.ID)
@@ -399,7 +517,10 @@ func (r *machineResource) Create(ctx context.Context, req resource.CreateRequest
 	// Only computed attributes must be updated here
 
 	plan.ID = types.StringValue(machine.ID)
-	fillStateWithMachineData(&plan, machine)
+	resp.Diagnostics.Append(fillStateWithMachineData(ctx, &plan, machine)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -419,11 +540,20 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultMachineReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed machine value from Paperspace
-	machine, err := r.client.GetMachine(state.ID.ValueString())
+	machine, err := r.client.GetMachine(ctx, state.ID.ValueString())
 	if err != nil {
 		// Avoid error due to 404 status. Machine may be deleted outside provider, so handle this as expected case.
-		if strings.Contains(err.Error(), "status: 404") {
+		if psclient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Machine %s not found, removing from state", state.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 			return
@@ -444,7 +574,23 @@ func (r *machineResource) Read(ctx context.Context, req resource.ReadRequest, re
 	tflog.Info(ctx, "Fetched machine data: "+string(machineJson))
 
 	// ID not needed here
-	fillStateWithMachineData(&state, machine)
+	resp.Diagnostics.Append(fillStateWithMachineData(ctx, &state, machine)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Accessors are managed only if they are set in configuration
+	if !state.AccessorIDs.IsNull() {
+		accessorIDs, err := r.getMachineAccessorIDs(ctx, machine.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading Paperspace Machine Accessors",
+				"Could not read accessors of Paperspace machine ID "+machine.ID+": "+err.Error(),
+			)
+			return
+		}
+		state.AccessorIDs = accessorIDs
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -465,6 +611,21 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultMachineUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ctx, diags = withEventPolicy(ctx, r.client, plan.EventPolicy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	machineID := plan.ID.ValueString()
 	machineStateCurrent := state.State.ValueString()
 	machineStateTarget := plan.State.ValueString()
@@ -481,7 +642,7 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 	if !plan.MachineType.Equal(state.MachineType) || !plan.DiskSize.Equal(state.DiskSize) {
 		// Make sure machine is off
 		tflog.Info(ctx, "Stopping machine before update, ID: "+machineID)
-		err := r.client.ManageMachineState(machineID, psclient.MachineStateOff)
+		err := r.client.ManageMachineState(ctx, machineID, psclient.MachineStateOff)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error stopping Paperspace machine",
@@ -497,6 +658,7 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 		AutoSnapshotFrequency: plan.AutoSnapshotFrequency.ValueString(),
 		AutoSnapshotSaveCount: getValueInt64Pointer(plan.AutoSnapshotSaveCount),
 		AutoShutdownTimeout:   getValueInt64Pointer(plan.AutoShutdownTimeout),
+		RestorePointFrequency: plan.RestorePointFrequency.ValueString(),
 	}
 
 	if !plan.Name.Equal(state.Name) {
@@ -531,10 +693,18 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 		reqData.AutoShutdownForce = getValueBoolPointer(plan.AutoShutdownForce)
 	}
 
+	if !plan.RestorePointEnabled.Equal(state.RestorePointEnabled) {
+		reqData.RestorePointEnabled = getValueBoolPointer(plan.RestorePointEnabled)
+	}
+
+	if !plan.RestorePointSnapshotID.IsUnknown() && !plan.RestorePointSnapshotID.Equal(state.RestorePointSnapshotID) {
+		reqData.RestorePointSnapshotID = plan.RestorePointSnapshotID.ValueString()
+	}
+
 	jsonData, _ := json.MarshalIndent(reqData, "", " ")
 	tflog.Info(ctx, "Sending update req data: "+string(jsonData))
 
-	err := r.client.UpdateMachine(machineID, reqData)
+	err := r.client.UpdateMachine(ctx, machineID, reqData)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -544,9 +714,31 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	// Grant and revoke machine access
+	if !plan.AccessorIDs.IsNull() && !plan.AccessorIDs.Equal(state.AccessorIDs) {
+		currentAccessorIDs := state.AccessorIDs
+
+		// Accessors were not managed before, so compare with the actual ones
+		if currentAccessorIDs.IsNull() {
+			currentAccessorIDs, err = r.getMachineAccessorIDs(ctx, machineID)
+		}
+
+		if err == nil {
+			err = r.updateMachineAccessors(ctx, machineID, getStringElements(currentAccessorIDs), getStringElements(plan.AccessorIDs))
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating Paperspace machine accessors",
+				"Could not update accessors of Paperspace machine ID "+machineID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Start/stop the machine based on target state
 	tflog.Info(ctx, fmt.Sprintf("Ensuring machine '%s' is '%s'", machineID, machineStateTarget))
-	err = r.client.ManageMachineState(machineID, machineStateTarget)
+	err = r.client.ManageMachineState(ctx, machineID, machineStateTarget)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error managing Paperspace machine state",
@@ -556,7 +748,7 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Fetch updated machine
-	updatedMachine, err := r.client.GetMachine(machineID)
+	updatedMachine, err := r.client.GetMachine(ctx, machineID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading updated Paperspace machine",
@@ -572,7 +764,10 @@ func (r *machineResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	tflog.Info(ctx, "Updated machine data: "+string(updatedMachineJson))
 
-	fillStateWithMachineData(&plan, updatedMachine)
+	resp.Diagnostics.Append(fillStateWithMachineData(ctx, &plan, updatedMachine)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
@@ -591,14 +786,24 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultMachineDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	ctx, diags = withEventPolicy(ctx, r.client, state.EventPolicy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	machineID := state.ID.ValueString()
-	err := r.client.DeleteMachine(machineID)
+	err := r.client.DeleteMachine(ctx, machineID)
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
-			tflog.Info(ctx, fmt.Sprintf("Machine %s not found, assuming already deleted", machineID))
-			return
-		}
-
 		resp.Diagnostics.AddError(
 			"Error Deleting Machine",
 			"Could not delete machine, unexpected error: "+err.Error(),
@@ -607,6 +812,83 @@ func (r *machineResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// ImportState imports an existing machine by ID.
+// The API does not return the template a machine was created from, so it may be passed
+// in the "<machine_id>:<template_id>" form to avoid replacement of the imported machine.
+// All other configurable attributes are restored from the machine data, except those which
+// apply only on creation: they are set to their defaults, and later plans keep them,
+// or left null to be set from the configuration without replacement, like template_id.
+func (r *machineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	machineID, templateID, _ := strings.Cut(req.ID, ":")
+	if machineID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <machine_id> or <machine_id>:<template_id>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	machine, err := r.client.GetMachine(ctx, machineID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Paperspace Machine",
+			"Could not read Paperspace machine ID "+machineID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), machine.ID)...)
+	if templateID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("template_id"), templateID)...)
+	} else {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("template_id"),
+			"Unknown Machine Template",
+			fmt.Sprintf("The template of machine %s can't be read from the API, so template_id is set from the configuration on the next apply, "+
+				"without replacing the machine. Make sure it's the template the machine was created from, "+
+				"or import the machine with the <machine_id>:<template_id> identifier.", machineID),
+		)
+	}
+
+	// Region short name is not returned by API, but it's part of the full one, e.g. "East Coast (NY2)"
+	if region := parseMachineRegion(machine.RegionFull); region != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	}
+
+	if diskSize, err := parseMachineDiskSize(machine.StorageTotal); err == nil {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("disk_size"), diskSize)...)
+	} else {
+		resp.Diagnostics.AddWarning(
+			"Unable to Determine Machine Disk Size",
+			fmt.Sprintf("Could not parse storage total %q of machine %s, disk_size must be set in configuration: %s", machine.StorageTotal, machineID, err),
+		)
+	}
+
+	// Startup script is not a part of machine data, so look for the script assigned to the machine
+	startupScripts, err := r.client.GetStartupScripts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Paperspace Machine",
+			"Could not read Paperspace startup scripts: "+err.Error(),
+		)
+		return
+	}
+
+	for _, startupScript := range *startupScripts {
+		for _, assignedMachineID := range startupScript.AssignedMachineIDs {
+			if assignedMachineID == machine.ID {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("startup_script_id"), startupScript.ID)...)
+			}
+		}
+	}
+
+	// Attributes which apply only on creation can't be fetched, so fill them with defaults.
+	// Configured values of take_initial_snapshot and email_password don't cause an update afterwards.
+	// NVLink is left null, so the configured value is set on the next apply without replacing the machine.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("take_initial_snapshot"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email_password"), true)...)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *machineResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -629,7 +911,83 @@ func (r *machineResource) Configure(_ context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
-func fillStateWithMachineData(state *machineResourceModel, machine *psclient.Machine) {
+// Returns IDs of users who have access to the machine.
+func (r *machineResource) getMachineAccessorIDs(ctx context.Context, machineID string) (types.Set, error) {
+	accessors, err := r.client.GetMachineAccessors(ctx, machineID)
+	if err != nil {
+		return types.SetNull(types.StringType), err
+	}
+
+	accessorIDs := []attr.Value{}
+	for _, accessor := range *accessors {
+		accessorIDs = append(accessorIDs, types.StringValue(accessor.User.ID))
+	}
+
+	return types.SetValueMust(types.StringType, accessorIDs), nil
+}
+
+// Adds accessors which are only in the target list and removes ones which are only in the current list.
+func (r *machineResource) updateMachineAccessors(ctx context.Context, machineID string, current []string, target []string) error {
+	for _, userID := range target {
+		if !slices.Contains(current, userID) {
+			tflog.Info(ctx, fmt.Sprintf("Granting user %s access to machine %s", userID, machineID))
+			if err := r.client.AddMachineAccessor(ctx, machineID, userID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, userID := range current {
+		if !slices.Contains(target, userID) {
+			tflog.Info(ctx, fmt.Sprintf("Revoking access of user %s to machine %s", userID, machineID))
+			if err := r.client.RemoveMachineAccessor(ctx, machineID, userID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Sets state of the machine which was created, but failed to become ready.
+// State must not contain unknown values, so the ones which can't be filled from the machine data are set to null.
+func (r *machineResource) setPartiallyCreatedMachineState(ctx context.Context, state *tfsdk.State, plan machineResourceModel, machine *psclient.Machine) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Machine data returned on creation is outdated, use it only if the machine can't be fetched
+	if currentMachine, err := r.client.GetMachine(ctx, machine.ID); err == nil {
+		machine = currentMachine
+	} else {
+		tflog.Warn(ctx, "Could not fetch partially created machine "+machine.ID+": "+err.Error())
+	}
+
+	plan.ID = types.StringValue(machine.ID)
+	diags.Append(fillStateWithMachineData(ctx, &plan, machine)...)
+
+	diags.Append(state.Set(ctx, plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	raw, err := tftypes.Transform(state.Raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() {
+			return value, nil
+		}
+		return tftypes.NewValue(value.Type(), nil), nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Error saving partially created machine",
+			"Could not set unknown attributes of machine "+machine.ID+" to null: "+err.Error(),
+		)
+		return diags
+	}
+	state.Raw = raw
+
+	return diags
+}
+
+func fillStateWithMachineData(ctx context.Context, state *machineResourceModel, machine *psclient.Machine) diag.Diagnostics {
 	state.Name = types.StringValue(machine.Name)
 	state.State = types.StringValue(machine.State)
 	state.OS = types.StringValue(machine.OS)
@@ -657,14 +1015,62 @@ func fillStateWithMachineData(state *machineResourceModel, machine *psclient.Mac
 	state.RestorePointEnabled = types.BoolValue(machine.RestorePointEnabled)
 	state.RestorePointFrequency = types.StringPointerValue(machine.RestorePointFrequency)   // Nullable field
 	state.RestorePointSnapshotID = types.StringPointerValue(machine.RestorePointSnapshotID) // Nullable field
+
+	// API keeps the frequency of disabled restore point, but it can't be configured then
+	if !machine.RestorePointEnabled {
+		state.RestorePointFrequency = types.StringNull()
+	}
 	state.UsageRate = types.Float64Value(machine.UsageRate)
 	state.StorageRate = types.Float64Value(machine.StorageRate)
 	state.DtCreated = types.StringValue(machine.DtCreated)
 	state.DtModified = types.StringValue(machine.DtModified)
+	state.DtDeleted = types.StringPointerValue(machine.DtDeleted) // Nullable field
+
+	var diags, d diag.Diagnostics
+
+	state.Accelerators, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: machineAcceleratorAttrTypes}, newMachineAcceleratorModels(machine.Accelerators))
+	diags.Append(d...)
+
+	// Nil reservation model results in null object
+	state.Reservation, d = types.ObjectValueFrom(ctx, machineReservationAttrTypes, newMachineReservationModel(machine.Reservation))
+	diags.Append(d...)
+
+	return diags
 }
 
 // Private
 
+var machineRegionRegexp = regexp.MustCompile(`\(([A-Za-z0-9]+)\)$`)
+
+// Returns short region name from the full one, e.g. "ny2" from "East Coast (NY2)".
+func parseMachineRegion(regionFull string) string {
+	match := machineRegionRegexp.FindStringSubmatch(regionFull)
+	if match == nil {
+		return ""
+	}
+	return strings.ToLower(match[1])
+}
+
+// Returns disk size in gigabytes from machine storage total in bytes.
+func parseMachineDiskSize(storageTotal string) (int64, error) {
+	bytes, err := strconv.ParseInt(storageTotal, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return bytes / (1 << 30), nil
+}
+
+// Returns string values of the set elements, null and unknown elements are skipped.
+func getStringElements(attr basetypes.SetValue) []string {
+	values := []string{}
+	for _, item := range attr.Elements() {
+		if strValue, ok := item.(types.String); ok && !strValue.IsNull() && !strValue.IsUnknown() {
+			values = append(values, strValue.ValueString())
+		}
+	}
+	return values
+}
+
 // Returns nil for unknown and ValueBoolPointer for known.
 func getValueBoolPointer(attr basetypes.BoolValue) *bool {
 	if attr.IsUnknown() {
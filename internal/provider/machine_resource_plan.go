@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure createOnlyModifier satisfies the plan modifier interface.
+var _ planmodifier.Bool = createOnlyModifier{}
+
+// createOnlyModifier keeps the prior state value of an attribute which applies only on creation,
+// so changing it in configuration, or importing a machine, doesn't plan an update which does nothing.
+type createOnlyModifier struct{}
+
+func (m createOnlyModifier) Description(_ context.Context) string {
+	return "Changes of the value are ignored once the resource is created."
+}
+
+func (m createOnlyModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m createOnlyModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	// Nothing to keep on creation or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.StateValue.IsNull() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
+
+// createOnly returns a plan modifier which ignores changes of the value once the resource is created.
+func createOnly() planmodifier.Bool {
+	return createOnlyModifier{}
+}
+
+// Checks machine availability during plan, so unavailable machine type fails before any API changes.
+// New machines can't be created if the type is unavailable, so it's an error.
+// Existing machines only warn on machine type change, because the machine is still usable.
+func (r *machineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Changed create-only attributes keep their state value, see createOnly, but the framework
+	// has already marked computed attributes as unknown, so the plan would show an update doing nothing
+	if !req.State.Raw.IsNull() && req.Config.Raw.IsFullyKnown() && isPlanUnchanged(req.Plan.Raw, req.State.Raw) {
+		resp.Plan.Raw = req.State.Raw
+		return
+	}
+
+	var plan, state machineResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Values are known only during apply
+	if plan.Region.IsUnknown() || plan.MachineType.IsUnknown() {
+		return
+	}
+
+	isCreate := req.State.Raw.IsNull()
+	if !isCreate {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Attributes forcing replacement create the machine again
+		isCreate = machineRequiresReplace(plan, state)
+
+		if !isCreate && plan.MachineType.Equal(state.MachineType) {
+			return
+		}
+	}
+
+	region := plan.Region.ValueString()
+	machineType := plan.MachineType.ValueString()
+
+	availability, err := r.client.GetMachineAvailability(ctx, region, machineType)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to check Paperspace machine availability",
+			"Could not check availability of machine type "+machineType+" in region "+region+": "+err.Error(),
+		)
+		return
+	}
+
+	if availability.Available {
+		return
+	}
+
+	summary := "Machine type is not available"
+	detail := fmt.Sprintf("Machine type %s is not currently available in region %s.", machineType, region)
+
+	if isCreate {
+		resp.Diagnostics.AddAttributeError(path.Root("machine_type"), summary, detail+" Choose another machine type or region, or try again later.")
+	} else {
+		resp.Diagnostics.AddAttributeWarning(path.Root("machine_type"), summary, detail+" The machine may fail to start after the update.")
+	}
+}
+
+// Returns whether the plan changes any attribute which forces replacement of the machine.
+// Resource level ModifyPlan doesn't receive replacements requested by attribute plan modifiers,
+// so the attributes with RequiresReplace must be listed here.
+func machineRequiresReplace(plan machineResourceModel, state machineResourceModel) bool {
+	return !plan.Region.Equal(state.Region) ||
+		(!state.TemplateID.IsNull() && !plan.TemplateID.Equal(state.TemplateID)) ||
+		(!state.EnableNvlink.IsNull() && !plan.EnableNvlink.Equal(state.EnableNvlink)) ||
+		!plan.StartupScriptID.Equal(state.StartupScriptID)
+}
+
+// stringRequiresReplaceUnlessImported forces replacement when the value changes, unless it's set after import.
+// Values which can't be read from the API are null after import, so setting them must not replace the machine.
+func stringRequiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Forces replacement if changed, unless the value is set for the first time after import.",
+		"Forces replacement if changed, unless the value is set for the first time after import.",
+	)
+}
+
+// boolRequiresReplaceUnlessImported forces replacement when the value changes, unless it's set after import.
+func boolRequiresReplaceUnlessImported() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(_ context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"Forces replacement if changed, unless the value is set for the first time after import.",
+		"Forces replacement if changed, unless the value is set for the first time after import.",
+	)
+}
+
+// Returns whether the planned value equals the prior state, once unknown values are replaced by their state values.
+// It must be used only with fully known configuration, so unknown values are only the computed ones.
+func isPlanUnchanged(plan tftypes.Value, state tftypes.Value) bool {
+	merged, err := tftypes.Transform(plan, func(attributePath *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if value.IsKnown() {
+			return value, nil
+		}
+
+		stateValue, _, err := tftypes.WalkAttributePath(state, attributePath)
+		if err != nil {
+			return value, err
+		}
+
+		if stateValue, ok := stateValue.(tftypes.Value); ok {
+			return stateValue, nil
+		}
+		return value, fmt.Errorf("unexpected state value type %T at %s", stateValue, attributePath)
+	})
+
+	return err == nil && merged.Equal(state)
+}
@@ -1,13 +1,28 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
 	"testing"
 
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 const testAccMachineResourceName = "paperspace_machine.test"
 
+// Resource names of the machine resource configurations
+var testAccMachineResourceNames = map[string]string{
+	"CreateReadDefaults": "paperspace_machine.test_defaults",
+	"CreateStart":        "paperspace_machine.test_start",
+}
+
 // TODO: Consider using the files for configurations, it's natively supported by []resource.TestStep
 // TODO: Add 'startup_script_id' to test (once provider is able to create startup scripts)
 
@@ -99,129 +114,285 @@ resource "paperspace_machine" "test_start" {
 `,
 }
 
-func TestAccMachineResource(t *testing.T) {
-	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccMachineResourceConfigs["CreateRead"],
-				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
-					testAccMachineResourceName,
-					map[string]string{
-						"accessor_ids.#": "0",
-
-						// Verify created machine attributes
-						"name":                  "paperspace-provider-test-CreateRead",
-						"machine_type":          "C2",
-						"template_id":           "t0nspur5",
-						"disk_size":             "50",
-						"region":                "ny2",
-						"public_ip_type":        "dynamic",
-						"enable_nvlink":         "false",
-						"take_initial_snapshot": "true",
-						"email_password":        "false",
-						"state":                 "off",
-
-						// Verify machine has Computed attributes filled
-						"cpus":        "1",
-						"region_full": "East Coast (NY2)",
-
-						// Verify dynamic values have any value set in the state
-						"id":            "_any_",
-						"private_ip":    "_any_",
-						"ram":           "_any_",
-						"storage_total": "_any_",
-						"storage_used":  "_any_",
-						"usage_rate":    "_any_",
-						"storage_rate":  "_any_",
-					},
-				)...),
+var testAccMachineResourceSteps = []resource.TestStep{
+	{
+		Config: testAccMachineResourceConfigs["CreateRead"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccMachineResourceName,
+			map[string]string{
+				"accessor_ids.#": "0",
+
+				// Verify created machine attributes
+				"name":                  "paperspace-provider-test-CreateRead",
+				"machine_type":          "C2",
+				"template_id":           "t0nspur5",
+				"disk_size":             "50",
+				"region":                "ny2",
+				"public_ip_type":        "dynamic",
+				"enable_nvlink":         "false",
+				"take_initial_snapshot": "true",
+				"email_password":        "false",
+				"state":                 "off",
+
+				// Verify machine has Computed attributes filled
+				"cpus":           "1",
+				"region_full":    "East Coast (NY2)",
+				"accelerators.#": "0", // CPU-only machine type
+
+				// Verify dynamic values have any value set in the state
+				"id":            "_any_",
+				"private_ip":    "_any_",
+				"ram":           "_any_",
+				"storage_total": "_any_",
+				"storage_used":  "_any_",
+				"usage_rate":    "_any_",
+				"storage_rate":  "_any_",
 			},
-			// TODO: Add this once import is implemented
-			// ImportState testing
-			// {
-			// 	ResourceName:      testAccMachineResourceName,
-			// 	ImportState:       true,
-			// 	ImportStateVerify: true,
-			// 	// The last_updated attribute does not exist in the Paperspace
-			// 	// API, therefore there is no value for it during import.
-			// 	ImportStateVerifyIgnore: []string{"last_updated"},
-			// },
-
-			// Update and Read testing
-			{
-				Config: testAccMachineResourceConfigs["UpdateRead"],
-				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
-					testAccMachineResourceName,
-					map[string]string{
-						"accessor_ids.#": "0",
-
-						// Verify machine updated
-						"name":                     "paperspace-provider-test-UpdateRead",
-						"machine_type":             "C3",
-						"template_id":              "t0nspur5",
-						"disk_size":                "100",
-						"region":                   "ny2",
-						"public_ip_type":           "static",
-						"state":                    "off",
-						"auto_snapshot_enabled":    "true",
-						"auto_snapshot_save_count": "1",
-						"auto_snapshot_frequency":  "daily",
-						"auto_shutdown_enabled":    "true",
-						"auto_shutdown_force":      "true",
-						"auto_shutdown_timeout":    "1",
-						"cpus":                     "2",
-						"region_full":              "East Coast (NY2)",
-
-						// Verify dynamic values have any value set in the state.
-						"id":            "_any_",
-						"public_ip":     "_any_",
-						"ram":           "_any_",
-						"storage_total": "_any_",
-						"storage_used":  "_any_",
-						"usage_rate":    "_any_",
-						"storage_rate":  "_any_",
-					},
-				)...),
+		)...),
+	},
+	// ImportState testing
+	{
+		ResourceName:      testAccMachineResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateIdFunc: testAccMachineImportStateIdFunc(testAccMachineResourceName),
+		// Attributes which apply only on creation can't be read from the API,
+		// so they are set to defaults or left null during import.
+		ImportStateVerifyIgnore: []string{"take_initial_snapshot", "email_password", "enable_nvlink"},
+	},
+
+	// Update and Read testing
+	{
+		Config: testAccMachineResourceConfigs["UpdateRead"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccMachineResourceName,
+			map[string]string{
+				"accessor_ids.#": "0",
+
+				// Verify machine updated
+				"name":                     "paperspace-provider-test-UpdateRead",
+				"machine_type":             "C3",
+				"template_id":              "t0nspur5",
+				"disk_size":                "100",
+				"region":                   "ny2",
+				"public_ip_type":           "static",
+				"state":                    "off",
+				"auto_snapshot_enabled":    "true",
+				"auto_snapshot_save_count": "1",
+				"auto_snapshot_frequency":  "daily",
+				"auto_shutdown_enabled":    "true",
+				"auto_shutdown_force":      "true",
+				"auto_shutdown_timeout":    "1",
+				"cpus":                     "2",
+				"region_full":              "East Coast (NY2)",
+
+				// Verify dynamic values have any value set in the state.
+				"id":            "_any_",
+				"public_ip":     "_any_",
+				"ram":           "_any_",
+				"storage_total": "_any_",
+				"storage_used":  "_any_",
+				"usage_rate":    "_any_",
+				"storage_rate":  "_any_",
 			},
-		},
+		)...),
+	},
+}
+
+func TestAccMachineResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccMachineResourceSteps,
+	})
+}
+
+// Same steps against the fake API, runs offline.
+func TestMachineResource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccMachineResourceSteps),
 	})
 }
 
 // Test Defaults
 
+var testAccMachineResourceDefaultsSteps = []resource.TestStep{
+	{
+		Config: testAccMachineResourceConfigs["CreateReadDefaults"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			"paperspace_machine.test_defaults",
+			map[string]string{
+				"auto_shutdown_enabled": "false",
+				"auto_shutdown_force":   "false",
+				"auto_snapshot_enabled": "false",
+				"email_password":        "true",
+				"enable_nvlink":         "false",
+				"public_ip_type":        "dynamic",
+				"restore_point_enabled": "false",
+				"state":                 "off",
+				"take_initial_snapshot": "false",
+
+				"auto_shutdown_timeout":    "null",
+				"auto_snapshot_frequency":  "null",
+				"auto_snapshot_save_count": "null",
+				"restore_point_frequency":  "null",
+				"startup_script_id":        "null",
+				"dt_deleted":               "null",
+				"reservation":              "null",
+			},
+		)...),
+	},
+}
+
 func TestAccMachineResourceDefaults(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccMachineResourceConfigs["CreateReadDefaults"],
-				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
-					"paperspace_machine.test_defaults",
-					map[string]string{
-						"auto_shutdown_enabled": "false",
-						"auto_shutdown_force":   "false",
-						"auto_snapshot_enabled": "false",
-						"email_password":        "true",
-						"enable_nvlink":         "false",
-						"public_ip_type":        "dynamic",
-						"restore_point_enabled": "false",
-						"state":                 "off",
-						"take_initial_snapshot": "false",
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccMachineResourceDefaultsSteps,
+	})
+}
 
-						"auto_shutdown_timeout":    "null",
-						"auto_snapshot_frequency":  "null",
-						"auto_snapshot_save_count": "null",
-						"restore_point_frequency":  "null",
-						"startup_script_id":        "null",
-					},
-				)...),
+// Same steps against the fake API, runs offline.
+func TestMachineResourceDefaults(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccMachineResourceDefaultsSteps),
+	})
+}
+
+// Test Restore Point
+
+// Returns machine configuration with the given restore point attributes, the initial snapshot is the restore point.
+func testAccMachineRestorePointConfig(restorePoint string) string {
+	return providerConfig + fmt.Sprintf(`
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-RestorePoint"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+
+  take_initial_snapshot = true
+%s}
+`, restorePoint)
+}
+
+// Restore point is enabled, then disabled again, the initial snapshot stays the restore point snapshot.
+func testAccMachineRestorePointSteps() []resource.TestStep {
+	var snapshotID string
+
+	checkSnapshotID := func(state *terraform.State) error {
+		id := state.RootModule().Resources[testAccMachineResourceName].Primary.Attributes["restore_point_snapshot_id"]
+		if snapshotID == "" {
+			snapshotID = id
+		}
+		if id == "" || id != snapshotID {
+			return fmt.Errorf("expected restore point snapshot %q, got %q", snapshotID, id)
+		}
+		return nil
+	}
+
+	return []resource.TestStep{
+		{
+			Config: testAccMachineRestorePointConfig(""),
+			Check: resource.ComposeAggregateTestCheckFunc(append(genTestCheckFuncs(
+				testAccMachineResourceName,
+				map[string]string{
+					"restore_point_enabled":   "false",
+					"restore_point_frequency": "null",
+				},
+			), checkSnapshotID)...),
+		},
+		{
+			Config: testAccMachineRestorePointConfig(`
+  restore_point_enabled   = true
+  restore_point_frequency = "shutdown"
+`),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(testAccMachineResourceName, plancheck.ResourceActionUpdate),
+				},
 			},
+			Check: resource.ComposeAggregateTestCheckFunc(append(genTestCheckFuncs(
+				testAccMachineResourceName,
+				map[string]string{
+					"restore_point_enabled":   "true",
+					"restore_point_frequency": "shutdown",
+				},
+			), checkSnapshotID)...),
 		},
+		{
+			Config: testAccMachineRestorePointConfig(`
+  restore_point_enabled = false
+`),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(testAccMachineResourceName, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(append(genTestCheckFuncs(
+				testAccMachineResourceName,
+				map[string]string{
+					"restore_point_enabled":   "false",
+					"restore_point_frequency": "null",
+				},
+			), checkSnapshotID)...),
+		},
+	}
+}
+
+func TestAccMachineResourceRestorePoint(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccMachineRestorePointSteps(),
+	})
+}
+
+func TestMachineResourceRestorePoint(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccMachineRestorePointSteps()),
 	})
 }
 
 // Test Create and Start.
+var testAccMachineResourceCreateStartUpdateStopSteps = []resource.TestStep{
+	{
+		Config: testAccMachineResourceConfigs["CreateStart"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			"paperspace_machine.test_start",
+			map[string]string{
+				"state": "ready",
+			},
+		)...),
+	},
+	{
+		Config: testAccMachineResourceConfigs["UpdateStarted"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			"paperspace_machine.test_start",
+			map[string]string{
+				"name":      "paperspace-provider-test-UpdateStarted",
+				"state":     "ready",
+				"disk_size": "100",
+			},
+		)...),
+	},
+	{
+		Config: testAccMachineResourceConfigs["StopStarted"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			"paperspace_machine.test_start",
+			map[string]string{
+				"state": "off",
+			},
+		)...),
+	},
+}
+
 func TestAccMachineResourceCreateStartUpdateStop(t *testing.T) {
 	// Especially useful for CI, to skip test using 'go test -short'
 	if testing.Short() {
@@ -229,34 +400,450 @@ func TestAccMachineResourceCreateStartUpdateStop(t *testing.T) {
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccMachineResourceCreateStartUpdateStopSteps,
+	})
+}
+
+// Same steps against the fake API, runs offline and fast, so it's not skipped in short mode.
+func TestMachineResourceCreateStartUpdateStop(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccMachineResourceCreateStartUpdateStopSteps),
+	})
+}
+
+// Test accessors
+
+func testMachineAccessorsConfig(fake *psclienttest.Server, accessorIDs string) string {
+	return testFakeProviderConfig(fake) + fmt.Sprintf(`
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-Accessors"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+  accessor_ids = %s
+}
+`, accessorIDs)
+}
+
+// Checks the accessors of the machine in the fake API, in any order.
+func testCheckFakeMachineAccessors(client *psclient.Client, machineID *string, want ...string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		accessors, err := client.GetMachineAccessors(context.Background(), *machineID)
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, accessor := range *accessors {
+			got = append(got, accessor.User.ID)
+		}
+
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("machine accessors are %v, want %v", got, want)
+		}
+		return nil
+	}
+}
+
+// Accessors are granted and revoked in place, and accessors added outside of Terraform are detected.
+func TestMachineResourceAccessors(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	var machineID string
+	expectUpdate := resource.ConfigPlanChecks{
+		PreApply: []plancheck.PlanCheck{
+			plancheck.ExpectResourceAction(testAccMachineResourceName, plancheck.ResourceActionUpdate),
+		},
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMachineAccessorsConfig(fake, `["psu0001"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith(testAccMachineResourceName, "id", func(value string) error {
+						machineID = value
+						return nil
+					}),
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "accessor_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(testAccMachineResourceName, "accessor_ids.*", "psu0001"),
+					testCheckFakeMachineAccessors(client, &machineID, "psu0001"),
+				),
+			},
+			// Grant access to another user
+			{
+				Config:           testMachineAccessorsConfig(fake, `["psu0001", "psu0002"]`),
+				ConfigPlanChecks: expectUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "accessor_ids.#", "2"),
+					testCheckFakeMachineAccessors(client, &machineID, "psu0001", "psu0002"),
+				),
+			},
+			// Revoke access of the first user
+			{
+				Config:           testMachineAccessorsConfig(fake, `["psu0002"]`),
+				ConfigPlanChecks: expectUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "accessor_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr(testAccMachineResourceName, "accessor_ids.*", "psu0002"),
+					testCheckFakeMachineAccessors(client, &machineID, "psu0002"),
+				),
+			},
+			// Accessor added outside of Terraform is detected and revoked
+			{
+				PreConfig: func() {
+					if err := client.AddMachineAccessor(context.Background(), machineID, "psu0003"); err != nil {
+						t.Fatalf("AddMachineAccessor: %s", err)
+					}
+				},
+				Config:           testMachineAccessorsConfig(fake, `["psu0002"]`),
+				ConfigPlanChecks: expectUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "accessor_ids.#", "1"),
+					testCheckFakeMachineAccessors(client, &machineID, "psu0002"),
+				),
+			},
+		},
+	})
+}
+
+// Test timeouts
+
+func testMachineTimeoutsConfig(fake *psclienttest.Server, createTimeout string) string {
+	return testFakeProviderConfig(fake) + fmt.Sprintf(`
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-Timeouts"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+
+  timeouts {
+    create = %q
+    delete = "20m"
+  }
+
+  # Create event of the timed out machine never finishes, it must not block deletion of the machine
+  event_policy = {
+    ignore = ["create"]
+  }
+}
+`, createTimeout)
+}
+
+// Create timeout set in the timeouts block stops waiting for the machine.
+func TestMachineResourceTimeouts(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create event never finishes
+				PreConfig: func() {
+					fake.ScriptEvents("create", psclienttest.EventStep{State: "in progress"})
+				},
+				Config:      testMachineTimeoutsConfig(fake, "1s"),
+				ExpectError: regexp.MustCompile("timeout reached while waiting"),
+			},
+			{
+				PreConfig: func() {
+					fake.ScriptEvents("create")
+				},
+				Config: testMachineTimeoutsConfig(fake, "40m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "timeouts.create", "40m"),
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "timeouts.delete", "20m"),
+					resource.TestCheckResourceAttr(testAccMachineResourceName, "state", "off"),
+				),
+			},
+		},
+	})
+}
+
+// Returns configuration of a renamed machine with the event policy and a short update timeout.
+func testMachineEventPolicyConfig(fake *psclienttest.Server, name string, eventPolicy string) string {
+	return testFakeProviderConfig(fake) + fmt.Sprintf(`
+resource "paperspace_machine" "test" {
+  name         = %q
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+
+  timeouts {
+    update = "1s"
+  }
+%s}
+`, name, eventPolicy)
+}
+
+// Pending events of the machine are waited for on update, unless the event policy ignores them.
+func TestMachineResourceEventPolicy(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	var machineID string
+	saveMachineID := func(state *terraform.State) error {
+		machineID = state.RootModule().Resources[testAccMachineResourceName].Primary.ID
+		return nil
+	}
+
+	ignoreSnapshot := `
+  event_policy = {
+    ignore = ["snapshot"]
+  }
+`
+	ignoreSnapshotNullErrorAsSuccess := `
+  event_policy = {
+    ignore                = ["snapshot"]
+    null_error_as_success = true
+  }
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMachineResourceConfigs["CreateStart"],
+				Config: testMachineEventPolicyConfig(fake, "paperspace-provider-test-EventPolicy", ignoreSnapshot),
+				Check:  saveMachineID,
+			},
+			// Stale snapshot event never finishes, so it blocks the update unless it's ignored
+			{
+				PreConfig: func() {
+					fake.AddMachineEvent(psclient.Event{Name: "snapshot", State: "in progress", MachineID: machineID, DtCreated: "2024-01-01T00:00:00Z"})
+				},
+				Config:      testMachineEventPolicyConfig(fake, "paperspace-provider-test-EventPolicyWaited", ""),
+				ExpectError: regexp.MustCompile("timeout reached while waiting"),
+			},
+			{
+				Config: testMachineEventPolicyConfig(fake, "paperspace-provider-test-EventPolicyIgnored", ignoreSnapshot),
+				Check:  resource.TestCheckResourceAttr(testAccMachineResourceName, "name", "paperspace-provider-test-EventPolicyIgnored"),
+			},
+			// Event in error state without details fails the update, unless it's treated as success
+			{
+				PreConfig: func() {
+					fake.AddMachineEvent(psclient.Event{Name: "update", State: "error", MachineID: machineID, DtCreated: "2024-01-01T00:00:00Z"})
+				},
+				Config:      testMachineEventPolicyConfig(fake, "paperspace-provider-test-EventPolicyNullError", ignoreSnapshot),
+				ExpectError: regexp.MustCompile("unknown error during event"),
+			},
+			{
+				Config: testMachineEventPolicyConfig(fake, "paperspace-provider-test-EventPolicyNullErrorAsSuccess", ignoreSnapshotNullErrorAsSuccess),
 				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
-					"paperspace_machine.test_start",
+					testAccMachineResourceName,
 					map[string]string{
-						"state": "ready",
+						"name":                               "paperspace-provider-test-EventPolicyNullErrorAsSuccess",
+						"event_policy.ignore.#":              "1",
+						"event_policy.ignore.0":              "snapshot",
+						"event_policy.null_error_as_success": "true",
 					},
 				)...),
 			},
+		},
+	})
+}
+
+// Test failed creation
+
+// Machine created, but failed to become ready, is saved to state as tainted and replaced on the next apply.
+func TestMachineResourceCreateEventError(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventName string
+		config    string
+	}{
+		{name: "create", eventName: "create", config: "CreateReadDefaults"},
+		{name: "start", eventName: "start", config: "CreateStart"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			steps := testFakeSteps(fake, []resource.TestStep{
+				{Config: testAccMachineResourceConfigs[tt.config]},
+				{Config: testAccMachineResourceConfigs[tt.config]},
+			})
+			resourceName := testAccMachineResourceNames[tt.config]
+
+			message := "out of capacity"
+			steps[0].PreConfig = func() {
+				fake.ScriptEvents(tt.eventName, psclienttest.EventStep{State: "error", Error: &message, Finished: true})
+			}
+			steps[0].ExpectError = regexp.MustCompile(message)
+
+			steps[1].PreConfig = func() {
+				fake.ScriptEvents(tt.eventName)
+			}
+			steps[1].ConfigPlanChecks = resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+				},
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+				Steps:                    steps,
+			})
+		})
+	}
+}
+
+// Test availability check
+
+func testMachineAvailabilityConfig(fake *psclienttest.Server, templateID string) string {
+	return testFakeProviderConfig(fake) + fmt.Sprintf(`
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-Availability"
+  machine_type = "C2"
+  template_id  = %q
+  disk_size    = 50
+  region       = "ny2"
+}
+`, templateID)
+}
+
+// Replacement creates the machine again, so it must fail during plan if the machine type is unavailable.
+func TestMachineResourceReplaceUnavailable(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testMachineAvailabilityConfig(fake, "t0nspur5"),
+			},
 			{
-				Config: testAccMachineResourceConfigs["UpdateStarted"],
+				PreConfig: func() {
+					fake.SetMachineTypeAvailable("C2", false)
+				},
+				Config:      testMachineAvailabilityConfig(fake, "tkni3aa4"),
+				ExpectError: regexp.MustCompile("Machine type C2 is not currently available in region ny2"),
+			},
+		},
+	})
+}
+
+// Attributes which apply only on creation can't be imported, but their configured values
+// must not plan an update of the imported machine.
+func TestMachineResourceImportCreateOnly(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	takeInitialSnapshot, emailPassword := true, false
+	config := psclient.MachineCreateConfig{
+		Name:                "paperspace-provider-test-Import",
+		MachineType:         "C2",
+		TemplateID:          "t0nspur5",
+		DiskSize:            50,
+		Region:              "ny2",
+		TakeInitialSnapshot: &takeInitialSnapshot,
+		EmailPassword:       &emailPassword,
+	}
+
+	machine, err := fake.NewClient(t).CreateMachine(context.Background(), config)
+	if err != nil {
+		t.Fatalf("CreateMachine: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Apply fails on a non-empty plan after import
+				Config: testFakeProviderConfig(fake) + fmt.Sprintf(`
+import {
+  to = paperspace_machine.test
+  id = "%s:t0nspur5"
+}
+
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-Import"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+
+  take_initial_snapshot = true
+  email_password        = false
+}
+`, machine.ID),
 				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
-					"paperspace_machine.test_start",
+					testAccMachineResourceName,
 					map[string]string{
-						"name":      "paperspace-provider-test-UpdateStarted",
-						"state":     "ready",
-						"disk_size": "100",
+						"id": machine.ID,
+
+						// Imported defaults are kept
+						"take_initial_snapshot": "false",
+						"email_password":        "true",
 					},
 				)...),
 			},
+		},
+	})
+}
+
+// Template and NVLink can't be read from the API, so when the machine is imported by plain ID
+// their configured values must be set by an update, not by replacement of the machine.
+func TestMachineResourceImportWithoutTemplate(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	enableNvlink := true
+	config := psclient.MachineCreateConfig{
+		Name:         "paperspace-provider-test-Import",
+		MachineType:  "C2",
+		TemplateID:   "t0nspur5",
+		DiskSize:     50,
+		Region:       "ny2",
+		EnableNvlink: &enableNvlink,
+	}
+
+	machine, err := fake.NewClient(t).CreateMachine(context.Background(), config)
+	if err != nil {
+		t.Fatalf("CreateMachine: %s", err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
 			{
-				Config: testAccMachineResourceConfigs["StopStarted"],
-				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
-					"paperspace_machine.test_start",
-					map[string]string{
-						"state": "off",
+				Config: testFakeProviderConfig(fake) + fmt.Sprintf(`
+import {
+  to = paperspace_machine.test
+  id = "%s"
+}
+
+resource "paperspace_machine" "test" {
+  name          = "paperspace-provider-test-Import"
+  machine_type  = "C2"
+  template_id   = "t0nspur5"
+  disk_size     = 50
+  region        = "ny2"
+  enable_nvlink = true
+}
+`, machine.ID),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(testAccMachineResourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(append(
+					genTestCheckFuncs(testAccMachineResourceName, map[string]string{
+						"id":            machine.ID,
+						"template_id":   "t0nspur5",
+						"enable_nvlink": "true",
+					}),
+					func(*terraform.State) error {
+						if count := fake.CountRequests("POST", "/machines"); count != 1 {
+							return fmt.Errorf("expected the imported machine to be kept, got %d machine creations", count)
+						}
+						return nil
 					},
 				)...),
 			},
@@ -266,6 +853,18 @@ func TestAccMachineResourceCreateStartUpdateStop(t *testing.T) {
 
 // Private
 
+// Returns import ID in "<machine_id>:<template_id>" form, since template ID can't be read from the API.
+func testAccMachineImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.ID + ":" + rs.Primary.Attributes["template_id"], nil
+	}
+}
+
 func genTestCheckFuncs(resourceName string, attributes map[string]string) []resource.TestCheckFunc {
 	var checks []resource.TestCheckFunc
 
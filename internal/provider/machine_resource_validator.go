@@ -59,12 +59,15 @@ func (r *machineResource) ValidateConfig(ctx context.Context, req resource.Valid
 
 	// Restore Point
 
-	// NOTE: Restore point feature is not implemented yet
 	// If attribute is null, unknown or false
 	if !data.RestorePointEnabled.ValueBool() {
 		if !data.RestorePointFrequency.IsNull() {
 			addAttributeDepsError(&resp.Diagnostics, "restore_point_frequency", []string{"restore_point_enabled"})
 		}
+
+		if !data.RestorePointSnapshotID.IsNull() {
+			addAttributeDepsError(&resp.Diagnostics, "restore_point_snapshot_id", []string{"restore_point_enabled"})
+		}
 	} else { // Attribute is true
 		if data.RestorePointFrequency.IsNull() {
 			addAttributeDepsError(&resp.Diagnostics, "restore_point_enabled", []string{"restore_point_frequency"})
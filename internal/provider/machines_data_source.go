@@ -0,0 +1,515 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &machinesDataSource{}
+	_ datasource.DataSourceWithConfigure = &machinesDataSource{}
+)
+
+// NewMachinesDataSource is a helper function to simplify the provider implementation.
+func NewMachinesDataSource() datasource.DataSource {
+	return &machinesDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type machinesDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *machinesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_machines"
+}
+
+//// Data model types
+
+// machineAcceleratorModel maps machine accelerator schema data.
+type machineAcceleratorModel struct {
+	Name   types.String `tfsdk:"name"`
+	Memory types.String `tfsdk:"memory"`
+	Count  types.Int64  `tfsdk:"count"`
+}
+
+// machineReservationModel maps machine reservation schema data.
+type machineReservationModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	DtStarted  types.String `tfsdk:"dt_started"`
+	DtFinished types.String `tfsdk:"dt_finished"`
+	IsActive   types.Bool   `tfsdk:"is_active"`
+}
+
+// machineModel maps machine schema data.
+type machineModel struct {
+	ID                     types.String              `tfsdk:"id"`
+	Name                   types.String              `tfsdk:"name"`
+	State                  types.String              `tfsdk:"state"`
+	OS                     types.String              `tfsdk:"os"`
+	MachineType            types.String              `tfsdk:"machine_type"`
+	AgentType              types.String              `tfsdk:"agent_type"`
+	CPUs                   types.Int64               `tfsdk:"cpus"`
+	RAM                    types.String              `tfsdk:"ram"`
+	StorageTotal           types.String              `tfsdk:"storage_total"`
+	StorageUsed            types.String              `tfsdk:"storage_used"`
+	Accelerators           []machineAcceleratorModel `tfsdk:"accelerators"`
+	Region                 types.String              `tfsdk:"region"`
+	RegionFull             types.String              `tfsdk:"region_full"`
+	PrivateIP              types.String              `tfsdk:"private_ip"`
+	PrivateNetworkID       types.String              `tfsdk:"private_network_id"`
+	PublicIP               types.String              `tfsdk:"public_ip"`
+	PublicIPType           types.String              `tfsdk:"public_ip_type"`
+	AutoShutdownEnabled    types.Bool                `tfsdk:"auto_shutdown_enabled"`
+	AutoShutdownTimeout    types.Int64               `tfsdk:"auto_shutdown_timeout"`
+	AutoShutdownForce      types.Bool                `tfsdk:"auto_shutdown_force"`
+	AutoSnapshotEnabled    types.Bool                `tfsdk:"auto_snapshot_enabled"`
+	AutoSnapshotFrequency  types.String              `tfsdk:"auto_snapshot_frequency"`
+	AutoSnapshotSaveCount  types.Int64               `tfsdk:"auto_snapshot_save_count"`
+	UpdatesPending         types.Bool                `tfsdk:"updates_pending"`
+	RestorePointEnabled    types.Bool                `tfsdk:"restore_point_enabled"`
+	RestorePointFrequency  types.String              `tfsdk:"restore_point_frequency"`
+	RestorePointSnapshotID types.String              `tfsdk:"restore_point_snapshot_id"`
+	UsageRate              types.Float64             `tfsdk:"usage_rate"`
+	StorageRate            types.Float64             `tfsdk:"storage_rate"`
+	DtCreated              types.String              `tfsdk:"dt_created"`
+	DtModified             types.String              `tfsdk:"dt_modified"`
+	DtDeleted              types.String              `tfsdk:"dt_deleted"`
+	Reservation            *machineReservationModel  `tfsdk:"reservation"`
+}
+
+// machinesDataSourceModel maps the data source schema data.
+type machinesDataSourceModel struct {
+	// Filters
+	NameRegex        types.String `tfsdk:"name_regex"`
+	Region           types.String `tfsdk:"region"`
+	MachineType      types.String `tfsdk:"machine_type"`
+	State            types.String `tfsdk:"state"`
+	PrivateNetworkID types.String `tfsdk:"private_network_id"`
+
+	Machines []machineModel `tfsdk:"machines"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// The data source uses the Schema method to define the acceptable configuration and state attribute names and types.
+func (d *machinesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Machines of the team, optionally filtered by name, region, machine type, state and private network.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Return only machines with name matching this regular expression.",
+				Optional:            true,
+				Validators: []validator.String{
+					isRegex(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Return only machines in this region, e.g. `ny2`.",
+				Optional:            true,
+			},
+			"machine_type": schema.StringAttribute{
+				MarkdownDescription: "Return only machines of this type, e.g. `A4000`.",
+				Optional:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Return only machines in this state, e.g. `ready` or `off`.",
+				Optional:            true,
+			},
+			"private_network_id": schema.StringAttribute{
+				MarkdownDescription: "Return only machines in this private network.",
+				Optional:            true,
+			},
+			"machines": schema.ListNestedAttribute{
+				MarkdownDescription: "Found machines.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: machineDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Returns computed attributes of a single machine.
+func machineDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Machine ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Machine name.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "Machine state, e.g. `ready` or `off`.",
+			Computed:            true,
+		},
+		"os": schema.StringAttribute{
+			MarkdownDescription: "Operating system of the machine.",
+			Computed:            true,
+		},
+		"machine_type": schema.StringAttribute{
+			MarkdownDescription: "Machine type.",
+			Computed:            true,
+		},
+		"agent_type": schema.StringAttribute{
+			MarkdownDescription: "Agent type of the machine.",
+			Computed:            true,
+		},
+		"cpus": schema.Int64Attribute{
+			MarkdownDescription: "Number of CPUs.",
+			Computed:            true,
+		},
+		"ram": schema.StringAttribute{
+			MarkdownDescription: "RAM amount of the machine.",
+			Computed:            true,
+		},
+		"storage_total": schema.StringAttribute{
+			MarkdownDescription: "Storage total of the machine.",
+			Computed:            true,
+		},
+		"storage_used": schema.StringAttribute{
+			MarkdownDescription: "Storage used of the machine.",
+			Computed:            true,
+		},
+		"accelerators": schema.ListNestedAttribute{
+			MarkdownDescription: "Accelerators (GPUs) of the machine.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "Accelerator name.",
+						Computed:            true,
+					},
+					"memory": schema.StringAttribute{
+						MarkdownDescription: "Accelerator memory.",
+						Computed:            true,
+					},
+					"count": schema.Int64Attribute{
+						MarkdownDescription: "Number of accelerators.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Machine region, e.g. `ny2`.",
+			Computed:            true,
+		},
+		"region_full": schema.StringAttribute{
+			MarkdownDescription: "Full machine region name.",
+			Computed:            true,
+		},
+		"private_ip": schema.StringAttribute{
+			MarkdownDescription: "Private IP address of the machine.",
+			Computed:            true,
+		},
+		"private_network_id": schema.StringAttribute{
+			MarkdownDescription: "Private network ID, null if the machine is in the default network.",
+			Computed:            true,
+		},
+		"public_ip": schema.StringAttribute{
+			MarkdownDescription: "Public IP address of the machine.",
+			Computed:            true,
+		},
+		"public_ip_type": schema.StringAttribute{
+			MarkdownDescription: "The public IP type.",
+			Computed:            true,
+		},
+		"auto_shutdown_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether auto shutdown is enabled.",
+			Computed:            true,
+		},
+		"auto_shutdown_timeout": schema.Int64Attribute{
+			MarkdownDescription: "The auto shutdown timeout in hours.",
+			Computed:            true,
+		},
+		"auto_shutdown_force": schema.BoolAttribute{
+			MarkdownDescription: "Whether the machine is shut down forcibly.",
+			Computed:            true,
+		},
+		"auto_snapshot_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether auto snapshots are enabled.",
+			Computed:            true,
+		},
+		"auto_snapshot_frequency": schema.StringAttribute{
+			MarkdownDescription: "The auto snapshot frequency.",
+			Computed:            true,
+		},
+		"auto_snapshot_save_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of auto snapshots to save.",
+			Computed:            true,
+		},
+		"updates_pending": schema.BoolAttribute{
+			MarkdownDescription: "Whether the machine has pending updates.",
+			Computed:            true,
+		},
+		"restore_point_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether the machine disk is restored from the restore point snapshot.",
+			Computed:            true,
+		},
+		"restore_point_frequency": schema.StringAttribute{
+			MarkdownDescription: "The restore point frequency.",
+			Computed:            true,
+		},
+		"restore_point_snapshot_id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the restore point snapshot.",
+			Computed:            true,
+		},
+		"usage_rate": schema.Float64Attribute{
+			MarkdownDescription: "Usage rate of the machine.",
+			Computed:            true,
+		},
+		"storage_rate": schema.Float64Attribute{
+			MarkdownDescription: "Storage rate of the machine.",
+			Computed:            true,
+		},
+		"dt_created": schema.StringAttribute{
+			MarkdownDescription: "Created date timestamp of the machine.",
+			Computed:            true,
+		},
+		"dt_modified": schema.StringAttribute{
+			MarkdownDescription: "Modified date timestamp of the machine.",
+			Computed:            true,
+		},
+		"dt_deleted": schema.StringAttribute{
+			MarkdownDescription: "Deleted date timestamp of the machine.",
+			Computed:            true,
+		},
+		"reservation": schema.SingleNestedAttribute{
+			MarkdownDescription: "Machine reservation, null if the machine is not reserved.",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "Reservation ID.",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Reservation name.",
+					Computed:            true,
+				},
+				"dt_started": schema.StringAttribute{
+					MarkdownDescription: "Reservation started date timestamp.",
+					Computed:            true,
+				},
+				"dt_finished": schema.StringAttribute{
+					MarkdownDescription: "Reservation finished date timestamp.",
+					Computed:            true,
+				},
+				"is_active": schema.BoolAttribute{
+					MarkdownDescription: "Whether the reservation is active.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *machinesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state machinesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	machines, err := d.client.GetMachines(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace Machines",
+			err.Error(),
+		)
+		return
+	}
+
+	filter := machineFilter{
+		Region:           state.Region.ValueString(),
+		MachineType:      state.MachineType.ValueString(),
+		State:            state.State.ValueString(),
+		PrivateNetworkID: state.PrivateNetworkID.ValueString(),
+	}
+	// Regular expression is checked by isRegex validator
+	if re := compileRegex(state.NameRegex); re != nil {
+		filter.NameMatches = re.MatchString
+	}
+
+	// Map response body to model
+	state.Machines = []machineModel{}
+	for _, machine := range filter.apply(*machines) {
+		state.Machines = append(state.Machines, newMachineModel(&machine))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *machinesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// machineFilter holds configured filters, empty values match any machine.
+type machineFilter struct {
+	Name             string
+	NameMatches      func(string) bool
+	Region           string
+	MachineType      string
+	State            string
+	PrivateNetworkID string
+}
+
+// Returns machines matching the filter.
+func (f machineFilter) apply(machines []psclient.Machine) []psclient.Machine {
+	filtered := []psclient.Machine{}
+
+	for _, machine := range machines {
+		if f.matches(&machine) {
+			filtered = append(filtered, machine)
+		}
+	}
+
+	return filtered
+}
+
+func (f machineFilter) matches(machine *psclient.Machine) bool {
+	if f.Name != "" && machine.Name != f.Name {
+		return false
+	}
+
+	if f.NameMatches != nil && !f.NameMatches(machine.Name) {
+		return false
+	}
+
+	if f.Region != "" && normalizeRegion(machine.RegionFull) != normalizeRegion(f.Region) {
+		return false
+	}
+
+	if f.MachineType != "" && machine.MachineType != f.MachineType {
+		return false
+	}
+
+	if f.State != "" && machine.State != f.State {
+		return false
+	}
+
+	if f.PrivateNetworkID != "" && (!machine.IsPrivateNetwork || machine.NetworkID != f.PrivateNetworkID) {
+		return false
+	}
+
+	return true
+}
+
+func newMachineModel(machine *psclient.Machine) machineModel {
+	machineState := machineModel{
+		ID:                     types.StringValue(machine.ID),
+		Name:                   types.StringValue(machine.Name),
+		State:                  types.StringValue(machine.State),
+		OS:                     types.StringValue(machine.OS),
+		MachineType:            types.StringValue(machine.MachineType),
+		AgentType:              types.StringValue(machine.AgentType),
+		CPUs:                   types.Int64Value(machine.CPUs),
+		RAM:                    types.StringValue(machine.RAM),
+		StorageTotal:           types.StringValue(machine.StorageTotal),
+		StorageUsed:            types.StringValue(machine.StorageUsed),
+		Accelerators:           newMachineAcceleratorModels(machine.Accelerators),
+		Region:                 types.StringValue(normalizeRegion(machine.RegionFull)),
+		RegionFull:             types.StringValue(machine.RegionFull),
+		PrivateIP:              types.StringValue(machine.PrivateIP),
+		PrivateNetworkID:       types.StringNull(),
+		PublicIP:               types.StringPointerValue(machine.PublicIP), // Nullable field
+		PublicIPType:           types.StringValue(machine.PublicIPType),
+		AutoShutdownEnabled:    types.BoolValue(machine.AutoShutdownEnabled),
+		AutoShutdownTimeout:    types.Int64PointerValue(machine.AutoShutdownTimeout), // Nullable field
+		AutoShutdownForce:      types.BoolValue(machine.AutoShutdownForce),
+		AutoSnapshotEnabled:    types.BoolValue(machine.AutoSnapshotEnabled),
+		AutoSnapshotFrequency:  types.StringPointerValue(machine.AutoSnapshotFrequency), // Nullable field
+		AutoSnapshotSaveCount:  types.Int64PointerValue(machine.AutoSnapshotSaveCount),  // Nullable field
+		UpdatesPending:         types.BoolValue(machine.UpdatesPending),
+		RestorePointEnabled:    types.BoolValue(machine.RestorePointEnabled),
+		RestorePointFrequency:  types.StringPointerValue(machine.RestorePointFrequency),  // Nullable field
+		RestorePointSnapshotID: types.StringPointerValue(machine.RestorePointSnapshotID), // Nullable field
+		UsageRate:              types.Float64Value(machine.UsageRate),
+		StorageRate:            types.Float64Value(machine.StorageRate),
+		DtCreated:              types.StringValue(machine.DtCreated),
+		DtModified:             types.StringValue(machine.DtModified),
+		DtDeleted:              types.StringPointerValue(machine.DtDeleted), // Nullable field
+		Reservation:            newMachineReservationModel(machine.Reservation),
+	}
+
+	if machine.IsPrivateNetwork {
+		machineState.PrivateNetworkID = types.StringValue(machine.NetworkID)
+	}
+
+	return machineState
+}
+
+func newMachineAcceleratorModels(accelerators []psclient.Accelerator) []machineAcceleratorModel {
+	models := []machineAcceleratorModel{}
+	for _, accelerator := range accelerators {
+		models = append(models, machineAcceleratorModel{
+			Name:   types.StringValue(accelerator.Name),
+			Memory: types.StringValue(accelerator.Memory),
+			Count:  types.Int64Value(accelerator.Count),
+		})
+	}
+	return models
+}
+
+// Returns nil for machines without reservation, so the attribute is null.
+func newMachineReservationModel(reservation *psclient.Reservation) *machineReservationModel {
+	if reservation == nil {
+		return nil
+	}
+
+	return &machineReservationModel{
+		ID:         types.StringValue(reservation.ID),
+		Name:       types.StringValue(reservation.Name),
+		DtStarted:  timeStringValue(reservation.DtStarted),
+		DtFinished: timeStringValue(reservation.DtFinished),
+		IsActive:   types.BoolValue(reservation.IsActive),
+	}
+}
+
+// Returns the time in RFC 3339 format, or null if it's not set, e.g. finish of an open-ended reservation.
+func timeStringValue(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
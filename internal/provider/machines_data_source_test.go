@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMachinesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Filters must narrow the result to the test machine
+			{
+				Config: providerConfig + `
+resource "paperspace_machine" "test" {
+  name         = "paperspace-provider-test-MachinesDataSource"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+}
+
+data "paperspace_machines" "test" {
+  name_regex   = "^${paperspace_machine.test.name}$"
+  region       = paperspace_machine.test.region
+  machine_type = paperspace_machine.test.machine_type
+  state        = paperspace_machine.test.state
+}
+
+data "paperspace_machines" "none" {
+  name_regex = "^paperspace-provider-test-NoSuchMachine$"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_machines.test", "machines.#", "1"),
+					resource.TestCheckResourceAttrPair("data.paperspace_machines.test", "machines.0.id", "paperspace_machine.test", "id"),
+					resource.TestCheckResourceAttr("data.paperspace_machines.test", "machines.0.region", "ny2"),
+					resource.TestCheckResourceAttr("data.paperspace_machines.none", "machines.#", "0"),
+				),
+			},
+		},
+	})
+}
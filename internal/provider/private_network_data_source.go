@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &privateNetworkDataSource{}
+	_ datasource.DataSourceWithConfigure = &privateNetworkDataSource{}
+)
+
+// NewPrivateNetworkDataSource is a helper function to simplify the provider implementation.
+func NewPrivateNetworkDataSource() datasource.DataSource {
+	return &privateNetworkDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type privateNetworkDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *privateNetworkDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network"
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// Lookup arguments are the same attributes which are filled with the found private network data.
+func (d *privateNetworkDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := privateNetworkDataSourceAttributes()
+
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "ID of the private network to look up. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the private network to look up. The name must be unique within the region.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["region"] = schema.StringAttribute{
+		MarkdownDescription: "Region of the private network, e.g. `ny2`. Narrows the lookup by name.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Looks up a single private network by ID or name.",
+		Attributes:          attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *privateNetworkDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config privateNetworkModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var privateNetwork *psclient.PrivateNetwork
+
+	if !config.ID.IsNull() {
+		var err error
+		privateNetwork, err = d.client.GetPrivateNetwork(ctx, config.ID.ValueString())
+		if err != nil && !psclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Read Paperspace Private Network",
+				err.Error(),
+			)
+			return
+		}
+
+		if privateNetwork == nil {
+			resp.Diagnostics.AddError(
+				"Private Network Not Found",
+				fmt.Sprintf("Private network with ID %s does not exist.", config.ID.ValueString()),
+			)
+			return
+		}
+
+		if len(filterPrivateNetworks([]psclient.PrivateNetwork{*privateNetwork}, config.Name, config.Region)) == 0 {
+			resp.Diagnostics.AddError(
+				"Private Network Not Found",
+				fmt.Sprintf("Private network with ID %s is not in region %q.", config.ID.ValueString(), config.Region.ValueString()),
+			)
+			return
+		}
+	} else {
+		privateNetworks, err := d.client.GetPrivateNetworks(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Paperspace Private Networks",
+				err.Error(),
+			)
+			return
+		}
+
+		found := filterPrivateNetworks(*privateNetworks, config.Name, config.Region)
+		if len(found) != 1 {
+			resp.Diagnostics.AddError(
+				"Unable to Find Single Private Network",
+				fmt.Sprintf("Expected exactly one private network with name %q, found %d. Set region or use id to narrow the lookup.", config.Name.ValueString(), len(found)),
+			)
+			return
+		}
+
+		privateNetwork = &found[0]
+	}
+
+	state := newPrivateNetworkModel(privateNetwork)
+
+	// Keep region as it's written in configuration, it matches the found one
+	if !config.Region.IsNull() {
+		state.Region = config.Region
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *privateNetworkDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
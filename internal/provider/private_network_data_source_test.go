@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPrivateNetworkDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Lookup by ID and by name must find the same network
+			{
+				Config: providerConfig + `
+resource "paperspace_private_network" "test" {
+  name   = "paperspace-provider-test-PrivateNetworkDataSource"
+  region = "ny2"
+}
+
+data "paperspace_private_network" "by_id" {
+  id = paperspace_private_network.test.id
+}
+
+data "paperspace_private_network" "by_name" {
+  name   = paperspace_private_network.test.name
+  region = paperspace_private_network.test.region
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.paperspace_private_network.by_id", "name", "paperspace_private_network.test", "name"),
+					resource.TestCheckResourceAttrPair("data.paperspace_private_network.by_id", "network", "paperspace_private_network.test", "network"),
+					resource.TestCheckResourceAttr("data.paperspace_private_network.by_id", "region", "ny2"),
+					resource.TestCheckResourceAttrPair("data.paperspace_private_network.by_name", "id", "paperspace_private_network.test", "id"),
+					resource.TestCheckResourceAttrPair("data.paperspace_private_network.by_name", "netmask", "paperspace_private_network.test", "netmask"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"terraform-provider-paperspace/internal/psclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &privateNetworkResource{}
+	_ resource.ResourceWithConfigure   = &privateNetworkResource{}
+	_ resource.ResourceWithImportState = &privateNetworkResource{}
+)
+
+// Default operation timeouts, may be overridden with the timeouts block.
+const (
+	defaultPrivateNetworkCreateTimeout = 5 * time.Minute
+	defaultPrivateNetworkReadTimeout   = 5 * time.Minute
+	defaultPrivateNetworkUpdateTimeout = 5 * time.Minute
+	defaultPrivateNetworkDeleteTimeout = 5 * time.Minute
+)
+
+// NewPrivateNetworkResource is a helper function to simplify the provider implementation.
+func NewPrivateNetworkResource() resource.Resource {
+	return &privateNetworkResource{}
+}
+
+// Maps the resource schema data.
+// State/Plan structure.
+type privateNetworkResourceModel struct {
+	Name   types.String `tfsdk:"name"`   // required
+	Region types.String `tfsdk:"region"` // required
+
+	// Computed only
+	ID        types.String `tfsdk:"id"`
+	Network   types.String `tfsdk:"network"`
+	Netmask   types.String `tfsdk:"netmask"`
+	CIDR      types.String `tfsdk:"cidr"`
+	DtCreated types.String `tfsdk:"dt_created"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// privateNetworkResource is the resource implementation.
+type privateNetworkResource struct {
+	// Allow resource to store a reference to the client
+	client *psclient.Client
+}
+
+// Define the resource type name, which is how the resource is used in Terraform configurations.
+func (r *privateNetworkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_network"
+}
+
+// Define Schema
+// The resource uses the Schema method to define the supported configuration, plan, and state attribute names and types.
+func (r *privateNetworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Private network resource",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the private network.",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "The region to create the private network in. Forces resource replacement if changed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed only
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the private network.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "The network address of the private network.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"netmask": schema.StringAttribute{
+				MarkdownDescription: "The netmask of the private network.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"cidr": schema.StringAttribute{
+				MarkdownDescription: "The private network address range in CIDR notation, e.g. `10.0.0.0/24`.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dt_created": schema.StringAttribute{
+				MarkdownDescription: "The date the private network was created.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *privateNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan privateNetworkResourceModel
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultPrivateNetworkCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan and create new private network
+
+	reqData := psclient.PrivateNetworkCreateConfig{
+		Name:   plan.Name.ValueString(),   // required
+		Region: plan.Region.ValueString(), // required
+	}
+
+	jsonData, _ := json.MarshalIndent(reqData, "", " ")
+	tflog.Info(ctx, "Sending create req data: "+string(jsonData))
+
+	privateNetwork, err := r.client.CreatePrivateNetwork(ctx, reqData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating private network",
+			"Could not create private network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "Created a private network resource with id "+privateNetwork.ID)
+
+	plan.ID = types.StringValue(privateNetwork.ID)
+	fillStateWithPrivateNetworkData(&plan, privateNetwork)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *privateNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state privateNetworkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultPrivateNetworkReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed data from Paperspace
+	privateNetwork, err := r.client.GetPrivateNetwork(ctx, state.ID.ValueString())
+	if err != nil && !psclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Paperspace private network",
+			"Could not read Paperspace private network ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// API responds with "null" or 404 for missing networks. Network could be deleted outside provider, so handle this as expected case.
+	if privateNetwork == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Private network %s not found, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	fillStateWithPrivateNetworkData(&state, privateNetwork)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Updates the resource and sets the updated Terraform state on success.
+func (r *privateNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Fetch the entire plan and prior state
+	var plan, state privateNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultPrivateNetworkUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	privateNetworkID := state.ID.ValueString()
+
+	// Name is the only attribute which may be updated in place
+	reqData := psclient.PrivateNetworkUpdateConfig{
+		Name: plan.Name.ValueString(),
+	}
+
+	privateNetwork, err := r.client.UpdatePrivateNetwork(ctx, privateNetworkID, reqData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating private network",
+			"Could not update private network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	fillStateWithPrivateNetworkData(&plan, privateNetwork)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *privateNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state privateNetworkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultPrivateNetworkDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeletePrivateNetwork(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting private network",
+			"Could not delete private network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing private network by ID, all attributes are filled by Read.
+func (r *privateNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *privateNetworkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func fillStateWithPrivateNetworkData(state *privateNetworkResourceModel, privateNetwork *psclient.PrivateNetwork) {
+	state.Name = types.StringValue(privateNetwork.Name)
+	state.Network = types.StringValue(privateNetwork.Network)
+	state.Netmask = types.StringValue(privateNetwork.Netmask)
+	state.CIDR = types.StringValue(privateNetworkCIDR(privateNetwork.Network, privateNetwork.Netmask))
+	state.DtCreated = types.StringValue(privateNetwork.DtCreated)
+
+	// Keep region as it's written in configuration, if it's the same one
+	if normalizeRegion(state.Region.ValueString()) != normalizeRegion(privateNetwork.Region) {
+		state.Region = types.StringValue(normalizeRegion(privateNetwork.Region))
+	}
+}
+
+// Private
+
+// Returns network address range in CIDR notation, e.g. "10.0.0.0/24" for "10.0.0.0" and "255.255.255.0".
+// Returns empty string if the netmask is not valid.
+func privateNetworkCIDR(network string, netmask string) string {
+	maskIP := net.ParseIP(netmask).To4()
+	if maskIP == nil {
+		return ""
+	}
+
+	ones, bits := net.IPMask(maskIP).Size()
+	if bits == 0 {
+		return "" // Non-canonical mask
+	}
+
+	return fmt.Sprintf("%s/%d", network, ones)
+}
+
+// Returns region in the short form used in configuration, e.g. "ny2" for both "NY2" and "East Coast (NY2)".
+func normalizeRegion(region string) string {
+	if shortRegion := parseMachineRegion(region); shortRegion != "" {
+		return shortRegion
+	}
+	return strings.ToLower(region)
+}
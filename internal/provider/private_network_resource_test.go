@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const testAccPrivateNetworkResourceName = "paperspace_private_network.test"
+
+var testAccPrivateNetworkResourceConfigs = map[string]string{
+	"CreateRead": providerConfig + `
+resource "paperspace_private_network" "test" {
+  name   = "paperspace-provider-test-CreateRead"
+  region = "ny2"
+}
+`,
+
+	"UpdateRead": providerConfig + `
+resource "paperspace_private_network" "test" {
+  name   = "paperspace-provider-test-UpdateRead"
+  region = "ny2"
+}
+`,
+}
+
+var testAccPrivateNetworkResourceSteps = []resource.TestStep{
+	{
+		Config: testAccPrivateNetworkResourceConfigs["CreateRead"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccPrivateNetworkResourceName,
+			map[string]string{
+				"name":   "paperspace-provider-test-CreateRead",
+				"region": "ny2",
+
+				"id":         "_any_",
+				"network":    "_any_",
+				"netmask":    "_any_",
+				"cidr":       "_any_",
+				"dt_created": "_any_",
+			},
+		)...),
+	},
+	// ImportState testing
+	{
+		ResourceName:      testAccPrivateNetworkResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	},
+	// Update and Read testing, the network must not be replaced on rename
+	{
+		Config: testAccPrivateNetworkResourceConfigs["UpdateRead"],
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(testAccPrivateNetworkResourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccPrivateNetworkResourceName,
+			map[string]string{
+				"name":   "paperspace-provider-test-UpdateRead",
+				"region": "ny2",
+			},
+		)...),
+	},
+}
+
+func TestAccPrivateNetworkResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccPrivateNetworkResourceSteps,
+	})
+}
+
+// Same steps against the fake API, runs offline.
+func TestPrivateNetworkResource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccPrivateNetworkResourceSteps),
+	})
+}
+
+func testPrivateNetworkTimeoutsConfig(fake *psclienttest.Server, createTimeout string) string {
+	return testFakeProviderConfig(fake) + fmt.Sprintf(`
+resource "paperspace_private_network" "test" {
+  name   = "paperspace-provider-test-Timeouts"
+  region = "ny2"
+
+  timeouts {
+    create = %q
+    delete = "10m"
+  }
+}
+`, createTimeout)
+}
+
+// Create timeout set in the timeouts block cancels the API requests.
+func TestPrivateNetworkResourceTimeouts(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPrivateNetworkTimeoutsConfig(fake, "1ns"),
+				ExpectError: regexp.MustCompile(`context\s+deadline\s+exceeded`),
+			},
+			{
+				Config: testPrivateNetworkTimeoutsConfig(fake, "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(testAccPrivateNetworkResourceName, "timeouts.create", "10m"),
+					resource.TestCheckResourceAttr(testAccPrivateNetworkResourceName, "timeouts.delete", "10m"),
+					resource.TestCheckResourceAttrSet(testAccPrivateNetworkResourceName, "id"),
+				),
+			},
+		},
+	})
+}
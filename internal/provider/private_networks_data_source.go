@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &privateNetworksDataSource{}
+	_ datasource.DataSourceWithConfigure = &privateNetworksDataSource{}
+)
+
+// NewPrivateNetworksDataSource is a helper function to simplify the provider implementation.
+func NewPrivateNetworksDataSource() datasource.DataSource {
+	return &privateNetworksDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type privateNetworksDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *privateNetworksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_private_networks"
+}
+
+//// Data model types
+
+// privateNetworkModel maps private network schema data.
+type privateNetworkModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Region    types.String `tfsdk:"region"`
+	Network   types.String `tfsdk:"network"`
+	Netmask   types.String `tfsdk:"netmask"`
+	CIDR      types.String `tfsdk:"cidr"`
+	DtCreated types.String `tfsdk:"dt_created"`
+}
+
+// privateNetworksDataSourceModel maps the data source schema data.
+type privateNetworksDataSourceModel struct {
+	// Filters
+	Name   types.String `tfsdk:"name"`
+	Region types.String `tfsdk:"region"`
+
+	PrivateNetworks []privateNetworkModel `tfsdk:"private_networks"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// The data source uses the Schema method to define the acceptable configuration and state attribute names and types.
+func (d *privateNetworksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Private networks of the team, optionally filtered by name and region.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Return only private networks with this exact name.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Return only private networks in this region, e.g. `ny2`.",
+				Optional:            true,
+			},
+			"private_networks": schema.ListNestedAttribute{
+				MarkdownDescription: "Found private networks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: privateNetworkDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Returns computed attributes of a single private network.
+func privateNetworkDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Private network ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Private network name.",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Private network region.",
+			Computed:            true,
+		},
+		"network": schema.StringAttribute{
+			MarkdownDescription: "Private network address.",
+			Computed:            true,
+		},
+		"netmask": schema.StringAttribute{
+			MarkdownDescription: "Private network netmask.",
+			Computed:            true,
+		},
+		"cidr": schema.StringAttribute{
+			MarkdownDescription: "Private network address range in CIDR notation.",
+			Computed:            true,
+		},
+		"dt_created": schema.StringAttribute{
+			MarkdownDescription: "Private network created date timestamp.",
+			Computed:            true,
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *privateNetworksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state privateNetworksDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateNetworks, err := d.client.GetPrivateNetworks(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace Private Networks",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.PrivateNetworks = []privateNetworkModel{}
+	for _, privateNetwork := range filterPrivateNetworks(*privateNetworks, state.Name, state.Region) {
+		state.PrivateNetworks = append(state.PrivateNetworks, newPrivateNetworkModel(&privateNetwork))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *privateNetworksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// Returns private networks matching the name and region, null filters match any value.
+func filterPrivateNetworks(privateNetworks []psclient.PrivateNetwork, name types.String, region types.String) []psclient.PrivateNetwork {
+	filtered := []psclient.PrivateNetwork{}
+
+	for _, privateNetwork := range privateNetworks {
+		if !name.IsNull() && privateNetwork.Name != name.ValueString() {
+			continue
+		}
+
+		if !region.IsNull() && normalizeRegion(privateNetwork.Region) != normalizeRegion(region.ValueString()) {
+			continue
+		}
+
+		filtered = append(filtered, privateNetwork)
+	}
+
+	return filtered
+}
+
+func newPrivateNetworkModel(privateNetwork *psclient.PrivateNetwork) privateNetworkModel {
+	return privateNetworkModel{
+		ID:        types.StringValue(privateNetwork.ID),
+		Name:      types.StringValue(privateNetwork.Name),
+		Region:    types.StringValue(normalizeRegion(privateNetwork.Region)),
+		Network:   types.StringValue(privateNetwork.Network),
+		Netmask:   types.StringValue(privateNetwork.Netmask),
+		CIDR:      types.StringValue(privateNetworkCIDR(privateNetwork.Network, privateNetwork.Netmask)),
+		DtCreated: types.StringValue(privateNetwork.DtCreated),
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPrivateNetworksDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Read testing, the filters must match the created network only
+			{
+				Config: providerConfig + `
+resource "paperspace_private_network" "test" {
+  name   = "paperspace-provider-test-PrivateNetworksDataSource"
+  region = "ny2"
+}
+
+data "paperspace_private_networks" "test" {
+  name   = paperspace_private_network.test.name
+  region = paperspace_private_network.test.region
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_private_networks.test", "private_networks.#", "1"),
+					resource.TestCheckResourceAttrPair("data.paperspace_private_networks.test", "private_networks.0.id", "paperspace_private_network.test", "id"),
+					resource.TestCheckResourceAttr("data.paperspace_private_networks.test", "private_networks.0.region", "ny2"),
+					resource.TestCheckResourceAttrPair("data.paperspace_private_networks.test", "private_networks.0.cidr", "paperspace_private_network.test", "cidr"),
+				),
+			},
+		},
+	})
+}
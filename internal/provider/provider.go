@@ -2,14 +2,22 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
 	"terraform-provider-paperspace/internal/psclient"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,11 +31,38 @@ type paperspaceProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// httpClient, if set, is used for API requests instead of the default
+	// client, e.g. to record and replay them in tests.
+	httpClient *http.Client
+
+	// configureClient, if set, is called with the configured client, e.g. to
+	// collapse poll intervals when replaying recorded API requests in tests.
+	configureClient func(*psclient.Client)
 }
 
 // paperspaceProviderModel describes the provider data model.
 type paperspaceProviderModel struct {
-	APIKey types.String `tfsdk:"api_key"`
+	APIKey  types.String `tfsdk:"api_key"`
+	APIHost types.String `tfsdk:"api_host"`
+
+	TeamID        types.String `tfsdk:"team_id"`
+	TeamNamespace types.String `tfsdk:"team_namespace"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
+
+	RetryMaxAttempts types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryMaxWait     types.String `tfsdk:"retry_max_wait"`
+
+	EventPollInterval types.String `tfsdk:"event_poll_interval"`
+	StatePollInterval types.String `tfsdk:"state_poll_interval"`
+
+	EventPolicy *eventPolicyModel `tfsdk:"event_policy"`
+
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+	HTTPSProxy     types.String `tfsdk:"https_proxy"`
+	CABundle       types.String `tfsdk:"ca_bundle"`
+	ExtraHeaders   types.Map    `tfsdk:"extra_headers"`
 }
 
 func (p *paperspaceProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -39,9 +74,86 @@ func (p *paperspaceProvider) Schema(ctx context.Context, req provider.SchemaRequ
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "An API key or access token. May be set via the PAPERSPACE_API_KEY environment variable.",
+				MarkdownDescription: "An API key or access token. May be set via the PAPERSPACE_API_KEY environment variable. " +
+					"The key is checked on the first API request, so plans which don't call the API (e.g. of new resources only) work without it.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"api_host": schema.StringAttribute{
+				MarkdownDescription: "URL of the Paperspace API, e.g. of an internal API gateway. May be set via the PAPERSPACE_API_HOST environment variable. Defaults to `" + psclient.HostURL + "`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isURL(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team the API key must belong to, e.g. to prevent applying a configuration with the key of another team. " +
+					"API requests fail if the user is not a member of the team or the key was created in another team. May be set via the PAPERSPACE_TEAM_ID environment variable.",
+				Optional: true,
+			},
+			"team_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the team the API key must belong to, like `team_id`. May be set via the PAPERSPACE_TEAM_NAMESPACE environment variable. " +
+					"If both are set, they must identify the same team.",
+				Optional: true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip validation of the API key with an extra request before the first API request, e.g. for API gateways without the auth session endpoint. " +
+					"Ignored if `team_id` or `team_namespace` is set, since the team is validated with the same request. Defaults to `false`.",
+				Optional: true,
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of attempts for API requests failed with a transient error (network error, HTTP 429, 502, 503, 504). " +
+					"Requests which are not idempotent are retried only on HTTP 429. Set to `1` to disable retries. Defaults to `5`.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum delay between retry attempts, e.g. `30s` or `2m`. Also limits the delay requested by the API with the `Retry-After` header. Defaults to `30s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"event_poll_interval": schema.StringAttribute{
+				MarkdownDescription: "Interval between status checks of machine events while waiting for them to complete, e.g. `5s`. Defaults to `5s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"state_poll_interval": schema.StringAttribute{
+				MarkdownDescription: "Interval between status checks while waiting for a machine to reach the desired state or for a resource to be deleted, e.g. `10s`. Defaults to `10s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"event_policy": eventPolicyProviderAttribute(),
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single API request attempt, e.g. `30s` or `2m`. May be set via the PAPERSPACE_REQUEST_TIMEOUT environment variable. Defaults to `30s`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy for API requests, e.g. `http://proxy.example.com:3128`. May be set via the PAPERSPACE_HTTPS_PROXY environment variable. " +
+					"The standard `HTTPS_PROXY` and `NO_PROXY` environment variables are used if not set.",
+				Optional: true,
+				Validators: []validator.String{
+					isURL(),
+				},
+			},
+			"ca_bundle": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file with CA certificates trusted in addition to the system ones, e.g. of an API gateway or a TLS intercepting proxy. May be set via the PAPERSPACE_CA_BUNDLE environment variable.",
+				Optional:            true,
+			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Headers added to every API request, e.g. for an API gateway. `Authorization` and `Content-Type` headers can't be overridden.",
 				Optional:            true,
-				Sensitive:           true,
+				ElementType:         types.StringType,
 			},
 		},
 	}
@@ -73,6 +185,35 @@ func (p *paperspaceProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.APIHost.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_host"),
+			"Unknown Paperspace API Host",
+			"The provider cannot create the Paperspace API client as there is an unknown configuration value for the Paperspace API host. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the PAPERSPACE_API_HOST environment variable.",
+		)
+	}
+
+	// Unknown values would configure no retry delay or poll interval at all
+	for _, attribute := range []struct {
+		name  string
+		value attr.Value
+	}{
+		{"retry_max_attempts", config.RetryMaxAttempts},
+		{"retry_max_wait", config.RetryMaxWait},
+		{"event_poll_interval", config.EventPollInterval},
+		{"state_poll_interval", config.StatePollInterval},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown Paperspace Client Setting",
+				"The provider cannot configure the Paperspace API client as there is an unknown configuration value for "+attribute.name+". "+
+					"Either target apply the source of the value first, or set the value statically in the configuration.",
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -86,19 +227,59 @@ func (p *paperspaceProvider) Configure(ctx context.Context, req provider.Configu
 		api_key = config.APIKey.ValueString()
 	}
 
-	// If any of the expected configurations are missing, return
-	// errors with provider-specific guidance.
+	api_host := stringValueOrEnv(config.APIHost, "PAPERSPACE_API_HOST")
+	request_timeout := stringValueOrEnv(config.RequestTimeout, "PAPERSPACE_REQUEST_TIMEOUT")
+	https_proxy := stringValueOrEnv(config.HTTPSProxy, "PAPERSPACE_HTTPS_PROXY")
+	ca_bundle := stringValueOrEnv(config.CABundle, "PAPERSPACE_CA_BUNDLE")
+
+	// Credentials are checked on first API request, so plans of configurations
+	// which don't need the API (e.g. only new resources) work without them.
+	// Requests fail with a clear error, but warn early as well.
 
 	if api_key == "" {
-		resp.Diagnostics.AddAttributeError(
+		resp.Diagnostics.AddAttributeWarning(
 			path.Root("api_key"),
 			"Missing Paperspace API Key",
-			"The provider cannot create the Paperspace API client as there is a missing or empty value for the Paperspace API Key. "+
+			"The provider is configured without the Paperspace API Key, so resources and data sources which call the Paperspace API will fail. "+
 				"Set the api key value in the configuration or use the PAPERSPACE_API_KEY environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	// Values set by environment variables are not checked by the attribute validators
+
+	if api_host != "" {
+		if err := checkURL(api_host); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_host"),
+				"Invalid Paperspace API Host",
+				fmt.Sprintf("The Paperspace API host must be an absolute HTTP or HTTPS URL, got %q: %s", api_host, err),
+			)
+		}
+	}
+
+	httpConfig := psclient.HTTPConfig{
+		ProxyURL: https_proxy,
+		CABundle: ca_bundle,
+	}
+
+	if request_timeout != "" {
+		timeout, err := time.ParseDuration(request_timeout)
+		if err == nil && timeout <= 0 {
+			err = fmt.Errorf("duration must be positive")
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("The request timeout must be a positive duration, e.g. \"30s\", got %q: %s", request_timeout, err),
+			)
+		}
+		httpConfig.Timeout = timeout
+	}
+
+	resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &httpConfig.Headers, false)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -108,7 +289,28 @@ func (p *paperspaceProvider) Configure(ctx context.Context, req provider.Configu
 	tflog.Info(ctx, "Creating Paperspace client")
 
 	// Create a new Paperspace client using the configuration values
-	client, err := psclient.NewClient(nil, &api_key, ctx)
+	var host *string
+	if api_host != "" {
+		api_host = strings.TrimSuffix(api_host, "/")
+		host = &api_host
+	}
+
+	httpClient := p.httpClient
+	if httpClient == nil {
+		var err error
+		httpClient, err = psclient.NewHTTPClient(httpConfig)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Create Paperspace API HTTP Client",
+				"The provider cannot create the HTTP client for the Paperspace API. "+
+					"Check the https_proxy and ca_bundle values in the configuration or the PAPERSPACE_HTTPS_PROXY and PAPERSPACE_CA_BUNDLE environment variables.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	client, err := psclient.NewClientWithHTTPClient(httpClient, host, &api_key)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Paperspace API Client",
@@ -119,6 +321,41 @@ func (p *paperspaceProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
+	client.SkipCredentialsValidation = config.SkipCredentialsValidation.ValueBool()
+	client.TeamID = stringValueOrEnv(config.TeamID, "PAPERSPACE_TEAM_ID")
+	client.TeamNamespace = stringValueOrEnv(config.TeamNamespace, "PAPERSPACE_TEAM_NAMESPACE")
+
+	if !config.RetryMaxAttempts.IsNull() {
+		client.RetryPolicy.MaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	}
+
+	// Value format is checked by the attribute validator, but validators don't run on every configuration path
+	if !config.RetryMaxWait.IsNull() {
+		client.RetryPolicy.MaxDelay = parseDurationAttribute(path.Root("retry_max_wait"), config.RetryMaxWait, &resp.Diagnostics)
+	}
+
+	if !config.EventPollInterval.IsNull() {
+		client.EventPollInterval = parseDurationAttribute(path.Root("event_poll_interval"), config.EventPollInterval, &resp.Diagnostics)
+	}
+
+	if !config.StatePollInterval.IsNull() {
+		client.StatePollInterval = parseDurationAttribute(path.Root("state_poll_interval"), config.StatePollInterval, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client.EventPolicy, diags = config.EventPolicy.apply(ctx, client.EventPolicy)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if p.configureClient != nil {
+		p.configureClient(client)
+	}
+
 	// Make the Paperspace client available during DataSource and Resource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -131,12 +368,25 @@ func (p *paperspaceProvider) Resources(ctx context.Context) []func() resource.Re
 	return []func() resource.Resource{
 		NewMachineResource,
 		NewStartupScriptResource,
+		NewPrivateNetworkResource,
+		NewCustomTemplateResource,
+		NewSnapshotResource,
 	}
 }
 
 func (p *paperspaceProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewCustomTemplateDataSource,
 		NewCustomTemplatesDataSource,
+		NewCurrentUserDataSource,
+		NewMachineDataSource,
+		NewMachineAvailabilityDataSource,
+		NewMachineEventsDataSource,
+		NewMachinesDataSource,
+		NewPrivateNetworkDataSource,
+		NewPrivateNetworksDataSource,
+		NewSnapshotsDataSource,
+		NewTeamDataSource,
 	}
 }
 
@@ -147,3 +397,27 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// Returns the configuration value if set, or the environment variable value otherwise.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// Returns the positive duration set by the attribute, adding an attribute error if it's invalid.
+func parseDurationAttribute(attributePath path.Path, value types.String, diags *diag.Diagnostics) time.Duration {
+	duration, err := time.ParseDuration(value.ValueString())
+	if err == nil && duration <= 0 {
+		err = fmt.Errorf("duration must be positive")
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s must be a positive duration, e.g. \"30s\", got %q: %s", attributePath, value.ValueString(), err),
+		)
+	}
+	return duration
+}
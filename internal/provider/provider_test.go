@@ -1,8 +1,19 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclienttest"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 const (
@@ -19,10 +30,228 @@ provider "paperspace" {}
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
 // reattach.
-var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+//
+// API requests of the test are recorded to or replayed from its cassette in
+// testdata/cassettes when PAPERSPACE_CASSETTE is "record" or "replay".
+func testAccProtoV6ProviderFactories(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	mode := psclienttest.CassetteModeFromEnv(t)
+	if mode == psclienttest.CassetteOff {
+		return map[string]func() (tfprotov6.ProviderServer, error){
+			"paperspace": providerserver.NewProtocol6WithError(New("test")()),
+		}
+	}
+
+	// One cassette is shared by all provider instances of the test
+	cassette := psclienttest.NewCassette(t, testAccCassettePath(t), mode)
+
+	p := &paperspaceProvider{version: "test", httpClient: cassette.HTTPClient()}
+	if mode == psclienttest.CassetteReplay {
+		p.configureClient = psclienttest.SetMinimalDelays
+	}
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"paperspace": providerserver.NewProtocol6WithError(p),
+	}
+}
+
+// testAccCassettePath returns path of the cassette with API requests of the test.
+func testAccCassettePath(t *testing.T) string {
+	return filepath.Join("testdata", "cassettes", strings.ReplaceAll(t.Name(), "/", "_")+".json")
+}
+
+// testFakeProtoV6ProviderFactories are used to instantiate a provider in tests
+// against the fake API, which run offline and without TF_ACC, see testFakeSteps.
+var testFakeProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"paperspace": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testFakeProviderConfig returns the provider configuration for the fake API.
+// Minimal poll intervals and retry delays let scripted machine events complete
+// without noticeable waiting.
+func testFakeProviderConfig(fake *psclienttest.Server) string {
+	return fmt.Sprintf(`
+provider "paperspace" {
+  api_host            = %q
+  api_key             = %q
+  retry_max_wait      = "1ms"
+  event_poll_interval = "1ms"
+  state_poll_interval = "1ms"
+}
+`, fake.URL, fake.Token)
+}
+
+// testFakeSteps returns copies of the acceptance test steps configured for the
+// fake API, so the same checks run against both the real and the fake API.
+func testFakeSteps(fake *psclienttest.Server, steps []resource.TestStep) []resource.TestStep {
+	result := make([]resource.TestStep, len(steps))
+	for i, step := range steps {
+		step.Config = strings.Replace(step.Config, providerConfig, testFakeProviderConfig(fake), 1)
+		result[i] = step
+	}
+	return result
+}
+
+func TestMain(m *testing.M) {
+	// Replayed requests are not authenticated, but the provider requires an API key
+	if psclienttest.CassetteMode(os.Getenv(psclienttest.CassetteEnv)) == psclienttest.CassetteReplay && os.Getenv("PAPERSPACE_API_KEY") == "" {
+		os.Setenv("PAPERSPACE_API_KEY", psclienttest.RedactedToken)
+	}
+
+	os.Exit(m.Run())
+}
+
+// API host and other connection settings may be set by environment variables.
+func TestProviderConfigureEnv(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	t.Setenv("PAPERSPACE_API_HOST", fake.URL+"/")
+	t.Setenv("PAPERSPACE_API_KEY", fake.Token)
+	t.Setenv("PAPERSPACE_REQUEST_TIMEOUT", "1m")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "paperspace_machines" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.paperspace_machines.test", "machines.#", "0"),
+			},
+		},
+	})
+
+	if count := fake.CountRequests(http.MethodGet, "/machines"); count == 0 {
+		t.Error("expected machines to be listed by the fake API")
+	}
+}
+
+func TestProviderConfigureInvalid(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	tests := map[string]struct {
+		config      string
+		resources   string
+		env         map[string]string
+		expectError string
+	}{
+		"api_host": {
+			config:      `api_host = "api.paperspace.com"`,
+			expectError: "Invalid URL",
+		},
+		"api_host env": {
+			env:         map[string]string{"PAPERSPACE_API_HOST": "ftp://api.paperspace.com"},
+			expectError: "Invalid Paperspace API Host",
+		},
+		"request_timeout env": {
+			env:         map[string]string{"PAPERSPACE_REQUEST_TIMEOUT": "30"},
+			expectError: "Invalid Request Timeout",
+		},
+		"ca_bundle": {
+			config:      `ca_bundle = "testdata/missing.pem"`,
+			expectError: "Unable to Create Paperspace API HTTP Client",
+		},
+		"retry_max_wait unknown": {
+			config:      `retry_max_wait = terraform_data.duration.output`,
+			resources:   `resource "terraform_data" "duration" { input = "1s" }`,
+			expectError: "Unknown Paperspace Client Setting",
+		},
+		"event_poll_interval unknown": {
+			config:      `event_poll_interval = terraform_data.duration.output`,
+			resources:   `resource "terraform_data" "duration" { input = "1s" }`,
+			expectError: "Unknown Paperspace Client Setting",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("PAPERSPACE_API_HOST", fake.URL)
+			t.Setenv("PAPERSPACE_API_KEY", fake.Token)
+			for key, value := range test.env {
+				t.Setenv(key, value)
+			}
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+provider "paperspace" {
+  %s
+}
+
+data "paperspace_machines" "test" {}
+
+%s
+`, test.config, test.resources),
+						ExpectError: regexp.MustCompile(test.expectError),
+					},
+				},
+			})
+		})
+	}
+}
+
+// Plans which don't need the API work without credentials, and API calls fail with a clear error.
+func TestProviderWithoutCredentials(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	t.Setenv("PAPERSPACE_API_HOST", fake.URL)
+	t.Setenv("PAPERSPACE_API_KEY", "")
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "paperspace_startup_script" "test" {
+  name   = "test"
+  script = "echo 'Hello, World!'"
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: providerConfig + `
+data "paperspace_machines" "test" {}
+`,
+				ExpectError: regexp.MustCompile("no Paperspace API key is configured"),
+			},
+		},
+	})
+
+	if requests := fake.Requests(); len(requests) != 0 {
+		t.Errorf("expected no requests without credentials, got %v", requests)
+	}
+}
+
+func TestProviderSkipCredentialsValidation(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	t.Setenv("PAPERSPACE_API_HOST", fake.URL)
+	t.Setenv("PAPERSPACE_API_KEY", fake.Token)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "paperspace" {
+  skip_credentials_validation = true
+}
+
+data "paperspace_machines" "test" {}
+`,
+				Check: resource.TestCheckResourceAttr("data.paperspace_machines.test", "machines.#", "0"),
+			},
+		},
+	})
+
+	if count := fake.CountRequests(http.MethodGet, "/auth/session"); count != 0 {
+		t.Errorf("expected no auth session request, got %d", count)
+	}
+}
+
 //// Example
 // func testAccPreCheck(t *testing.T) {
 // 	// You can add code here to run prior to any test case execution, for example assertions
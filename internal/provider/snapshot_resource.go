@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"terraform-provider-paperspace/internal/psclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &snapshotResource{}
+	_ resource.ResourceWithConfigure   = &snapshotResource{}
+	_ resource.ResourceWithImportState = &snapshotResource{}
+)
+
+// Default operation timeouts, may be overridden with the timeouts block.
+const (
+	defaultSnapshotCreateTimeout = 30 * time.Minute
+	defaultSnapshotReadTimeout   = 5 * time.Minute
+	defaultSnapshotUpdateTimeout = 5 * time.Minute
+	defaultSnapshotDeleteTimeout = 10 * time.Minute
+)
+
+// NewSnapshotResource is a helper function to simplify the provider implementation.
+func NewSnapshotResource() resource.Resource {
+	return &snapshotResource{}
+}
+
+// Maps the resource schema data.
+// State/Plan structure.
+type snapshotResourceModel struct {
+	MachineID         types.String `tfsdk:"machine_id"` // required
+	Name              types.String `tfsdk:"name"`       // required
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+
+	// Computed only
+	ID        types.String `tfsdk:"id"`
+	DtCreated types.String `tfsdk:"dt_created"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// snapshotResource is the resource implementation.
+type snapshotResource struct {
+	// Allow resource to store a reference to the client
+	client *psclient.Client
+}
+
+// Define the resource type name, which is how the resource is used in Terraform configurations.
+func (r *snapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot"
+}
+
+// Define Schema
+// The resource uses the Schema method to define the supported configuration, plan, and state attribute names and types.
+func (r *snapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Manual machine snapshot resource. The snapshot is deleted on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"machine_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the machine to snapshot. Forces resource replacement if changed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the snapshot.",
+				Required:            true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for the snapshot to complete during creation. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+
+			// Computed only
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the snapshot.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dt_created": schema.StringAttribute{
+				MarkdownDescription: "The date the snapshot was created.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *snapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan snapshotResourceModel
+
+	// Read Terraform plan data into the model
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultSnapshotCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Generate API request body from plan and create new snapshot
+
+	reqData := psclient.SnapshotCreateConfig{
+		MachineID: plan.MachineID.ValueString(), // required
+		Name:      plan.Name.ValueString(),      // required
+	}
+
+	jsonData, _ := json.MarshalIndent(reqData, "", " ")
+	tflog.Info(ctx, "Sending create req data: "+string(jsonData))
+
+	snapshot, err := r.client.CreateSnapshot(ctx, reqData, plan.WaitForCompletion.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating snapshot",
+			"Could not create snapshot, unexpected error: "+err.Error(),
+		)
+
+		// Snapshot is taken, but not completed. Save it to state, so it's tainted instead of leaked
+		if snapshot != nil && snapshot.ID != "" {
+			fillStateWithSnapshotData(&plan, snapshot)
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		}
+		return
+	}
+	tflog.Info(ctx, "Created a snapshot resource with id "+snapshot.ID)
+
+	fillStateWithSnapshotData(&plan, snapshot)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state snapshotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultSnapshotReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get refreshed data from Paperspace
+	snapshot, err := r.client.GetSnapshot(ctx, state.ID.ValueString())
+	if err != nil {
+		// Avoid error due to 404 status. Resource could be deleted outside provider, so handle this as expected case.
+		if psclient.IsNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Snapshot %s not found, removing from state", state.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error Reading Paperspace snapshot",
+			"Could not read Paperspace snapshot ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	fillStateWithSnapshotData(&state, snapshot)
+
+	// Not returned by API, set default value after import
+	if state.WaitForCompletion.IsNull() {
+		state.WaitForCompletion = types.BoolValue(true)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Updates the resource and sets the updated Terraform state on success.
+func (r *snapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Fetch the entire plan and prior state
+	var plan, state snapshotResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultSnapshotUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	snapshotID := state.ID.ValueString()
+
+	// Name is the only attribute which may be updated in place, wait_for_completion matters for creation only
+	if !plan.Name.Equal(state.Name) {
+		reqData := psclient.SnapshotUpdateConfig{
+			Name: plan.Name.ValueString(),
+		}
+
+		_, err := r.client.UpdateSnapshot(ctx, snapshotID, reqData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating snapshot",
+				"Could not update snapshot, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Fetch updated snapshot
+	snapshot, err := r.client.GetSnapshot(ctx, snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading updated Paperspace snapshot",
+			"Could not read Paperspace snapshot ID "+snapshotID+": "+err.Error(),
+		)
+		return
+	}
+
+	fillStateWithSnapshotData(&plan, snapshot)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *snapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state snapshotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultSnapshotDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.DeleteSnapshot(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting snapshot",
+			"Could not delete snapshot, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing snapshot by ID, all attributes are filled by Read.
+func (r *snapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *snapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func fillStateWithSnapshotData(state *snapshotResourceModel, snapshot *psclient.Snapshot) {
+	state.ID = types.StringValue(snapshot.ID)
+	state.Name = types.StringValue(snapshot.Name)
+	state.DtCreated = types.StringValue(snapshot.DtCreated)
+
+	// Keep configured machine ID if API doesn't report it
+	if snapshot.MachineID != "" {
+		state.MachineID = types.StringValue(snapshot.MachineID)
+	}
+}
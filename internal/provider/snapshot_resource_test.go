@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccSnapshotResourceName = "paperspace_snapshot.test"
+
+const testAccSnapshotMachineConfig = `
+resource "paperspace_machine" "test_snapshot_parent" {
+  name         = "paperspace-provider-test-SnapshotParent"
+  machine_type = "C2"
+  template_id  = "t0nspur5"
+  disk_size    = 50
+  region       = "ny2"
+}
+`
+
+var testAccSnapshotResourceConfigs = map[string]string{
+	"CreateRead": providerConfig + testAccSnapshotMachineConfig + `
+resource "paperspace_snapshot" "test" {
+  name       = "paperspace-provider-test-CreateRead"
+  machine_id = paperspace_machine.test_snapshot_parent.id
+}
+
+data "paperspace_snapshots" "test" {
+  machine_id = paperspace_snapshot.test.machine_id
+  max_age    = "1h"
+}
+`,
+
+	"UpdateRead": providerConfig + testAccSnapshotMachineConfig + `
+resource "paperspace_snapshot" "test" {
+  name       = "paperspace-provider-test-UpdateRead"
+  machine_id = paperspace_machine.test_snapshot_parent.id
+}
+`,
+}
+
+var testAccSnapshotResourceSteps = []resource.TestStep{
+	{
+		Config: testAccSnapshotResourceConfigs["CreateRead"],
+		Check: resource.ComposeAggregateTestCheckFunc(append(genTestCheckFuncs(
+			testAccSnapshotResourceName,
+			map[string]string{
+				"name":                "paperspace-provider-test-CreateRead",
+				"wait_for_completion": "true",
+
+				"id":         "_any_",
+				"dt_created": "_any_",
+			},
+		),
+			resource.TestCheckResourceAttrPair(testAccSnapshotResourceName, "machine_id", "paperspace_machine.test_snapshot_parent", "id"),
+			resource.TestCheckResourceAttr("data.paperspace_snapshots.test", "snapshots.#", "1"),
+			resource.TestCheckResourceAttrPair("data.paperspace_snapshots.test", "snapshots.0.id", testAccSnapshotResourceName, "id"),
+		)...),
+	},
+	// ImportState testing
+	{
+		ResourceName:      testAccSnapshotResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	},
+	// Update and Read testing, the snapshot must not be replaced on rename
+	{
+		Config: testAccSnapshotResourceConfigs["UpdateRead"],
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(testAccSnapshotResourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccSnapshotResourceName,
+			map[string]string{
+				"name": "paperspace-provider-test-UpdateRead",
+			},
+		)...),
+	},
+}
+
+func TestAccSnapshotResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccSnapshotResourceSteps,
+	})
+}
+
+// Same steps against the fake API, runs offline. Snapshot must be deleted on destroy.
+func TestSnapshotResource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccSnapshotResourceSteps),
+		CheckDestroy:             testCheckFakeSnapshotsDestroyed(t, fake),
+	})
+}
+
+// Snapshot is taken by a machine event, which must be finished once the snapshot is created.
+func TestSnapshotResourceWaitForCompletion(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	fake.ScriptEvents("snapshot",
+		psclienttest.EventStep{State: "in progress"},
+		psclienttest.EventStep{State: "in progress"},
+		psclienttest.EventStep{State: "in progress"},
+		psclienttest.EventStep{State: "done", Finished: true},
+	)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Replace(testAccSnapshotResourceConfigs["UpdateRead"], providerConfig, testFakeProviderConfig(fake), 1),
+				Check: func(state *terraform.State) error {
+					machineID := state.RootModule().Resources[testAccSnapshotResourceName].Primary.Attributes["machine_id"]
+
+					events, err := fake.NewClient(t).GetMachineEvents(context.Background(), machineID)
+					if err != nil {
+						return err
+					}
+
+					for _, event := range *events {
+						if event.Name == "snapshot" && (event.State != "done" || event.DtFinished == nil) {
+							return fmt.Errorf("expected snapshot event to be done, got %s", event.State)
+						}
+					}
+					return nil
+				},
+			},
+		},
+		CheckDestroy: testCheckFakeSnapshotsDestroyed(t, fake),
+	})
+}
+
+// Returns check that snapshots in the state were deleted from the fake API.
+func testCheckFakeSnapshotsDestroyed(t *testing.T, fake *psclienttest.Server) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		client := fake.NewClient(t)
+		for _, rs := range state.RootModule().Resources {
+			if rs.Type != "paperspace_snapshot" {
+				continue
+			}
+
+			_, err := client.GetSnapshot(context.Background(), rs.Primary.ID)
+			if !psclient.IsNotFound(err) {
+				return fmt.Errorf("expected snapshot %s to be deleted, got %v", rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &snapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &snapshotsDataSource{}
+)
+
+// NewSnapshotsDataSource is a helper function to simplify the provider implementation.
+func NewSnapshotsDataSource() datasource.DataSource {
+	return &snapshotsDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type snapshotsDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *snapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshots"
+}
+
+//// Data model types
+
+// snapshotModel maps snapshot schema data.
+type snapshotModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	MachineID types.String `tfsdk:"machine_id"`
+	DtCreated types.String `tfsdk:"dt_created"`
+}
+
+// snapshotsDataSourceModel maps the data source schema data.
+type snapshotsDataSourceModel struct {
+	// Filters
+	MachineID types.String `tfsdk:"machine_id"`
+	MinAge    types.String `tfsdk:"min_age"`
+	MaxAge    types.String `tfsdk:"max_age"`
+
+	Snapshots []snapshotModel `tfsdk:"snapshots"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+// The data source uses the Schema method to define the acceptable configuration and state attribute names and types.
+func (d *snapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Machine snapshots of the team, optionally filtered by machine and age.",
+		Attributes: map[string]schema.Attribute{
+			"machine_id": schema.StringAttribute{
+				MarkdownDescription: "Return only snapshots of this machine.",
+				Optional:            true,
+			},
+			"min_age": schema.StringAttribute{
+				MarkdownDescription: "Return only snapshots created at least this long ago, e.g. `168h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"max_age": schema.StringAttribute{
+				MarkdownDescription: "Return only snapshots created at most this long ago, e.g. `24h`.",
+				Optional:            true,
+				Validators: []validator.String{
+					isDuration(),
+				},
+			},
+			"snapshots": schema.ListNestedAttribute{
+				MarkdownDescription: "Found snapshots, sorted by creation date from oldest to newest.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Snapshot ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Snapshot name.",
+							Computed:            true,
+						},
+						"machine_id": schema.StringAttribute{
+							MarkdownDescription: "Snapshot machine ID.",
+							Computed:            true,
+						},
+						"dt_created": schema.StringAttribute{
+							MarkdownDescription: "Snapshot created date timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *snapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state snapshotsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Durations are checked by isDuration validator
+	now := time.Now()
+	var minAge, maxAge time.Duration
+	if !state.MinAge.IsNull() {
+		minAge, _ = time.ParseDuration(state.MinAge.ValueString())
+	}
+	if !state.MaxAge.IsNull() {
+		maxAge, _ = time.ParseDuration(state.MaxAge.ValueString())
+	}
+
+	snapshots, err := d.client.GetSnapshots(ctx, state.MachineID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace Snapshots",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Snapshots = []snapshotModel{}
+	for _, snapshot := range *snapshots {
+		dtCreated, err := time.Parse(time.RFC3339, snapshot.DtCreated)
+		if err != nil && (minAge > 0 || maxAge > 0) {
+			resp.Diagnostics.AddError(
+				"Unable to Filter Paperspace Snapshots",
+				fmt.Sprintf("Could not parse created date of snapshot %s: %s", snapshot.ID, err),
+			)
+			return
+		}
+
+		age := now.Sub(dtCreated)
+		if (minAge > 0 && age < minAge) || (maxAge > 0 && age > maxAge) {
+			continue
+		}
+
+		state.Snapshots = append(state.Snapshots, snapshotModel{
+			ID:        types.StringValue(snapshot.ID),
+			Name:      types.StringValue(snapshot.Name),
+			MachineID: types.StringValue(snapshot.MachineID),
+			DtCreated: types.StringValue(snapshot.DtCreated),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *snapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
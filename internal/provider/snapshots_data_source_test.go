@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			// Read testing, the new snapshot is younger than the maximum age, but not older than the minimum one
+			{
+				Config: providerConfig + testAccSnapshotMachineConfig + `
+resource "paperspace_snapshot" "test" {
+  name       = "paperspace-provider-test-SnapshotsDataSource"
+  machine_id = paperspace_machine.test_snapshot_parent.id
+}
+
+data "paperspace_snapshots" "young" {
+  machine_id = paperspace_snapshot.test.machine_id
+  max_age    = "1h"
+}
+
+data "paperspace_snapshots" "old" {
+  machine_id = paperspace_snapshot.test.machine_id
+  min_age    = "1h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.young", "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair("data.paperspace_snapshots.young", "snapshots.0.id", testAccSnapshotResourceName, "id"),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.old", "snapshots.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// Filters run against snapshots of different machines and ages, which can't be created with the real API.
+func TestSnapshotsDataSourceFilters(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	now := time.Now().UTC()
+	addSnapshot := func(machineID string, age time.Duration) string {
+		return fake.AddSnapshot(psclient.Snapshot{
+			Name:      fmt.Sprintf("%s-%s", machineID, age),
+			MachineID: machineID,
+			DtCreated: now.Add(-age).Format(time.RFC3339),
+		})
+	}
+
+	// Snapshots are listed from oldest to newest
+	week := addSnapshot("psm0001", 168*time.Hour)
+	twoDays := addSnapshot("psm0001", 36*time.Hour)
+	hour := addSnapshot("psm0001", time.Hour)
+	otherHour := addSnapshot("psm0002", time.Hour)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testFakeProviderConfig(fake) + `
+data "paperspace_snapshots" "all" {}
+
+data "paperspace_snapshots" "machine" {
+  machine_id = "psm0002"
+}
+
+data "paperspace_snapshots" "min_age" {
+  min_age = "48h"
+}
+
+data "paperspace_snapshots" "max_age" {
+  max_age = "24h"
+}
+
+data "paperspace_snapshots" "range" {
+  machine_id = "psm0001"
+  min_age    = "2h"
+  max_age    = "48h"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.all", "snapshots.#", "4"),
+
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.machine", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.machine", "snapshots.0.id", otherHour),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.machine", "snapshots.0.machine_id", "psm0002"),
+
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.min_age", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.min_age", "snapshots.0.id", week),
+
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.max_age", "snapshots.#", "2"),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.max_age", "snapshots.0.id", hour),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.max_age", "snapshots.1.id", otherHour),
+
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.range", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.paperspace_snapshots.range", "snapshots.0.id", twoDays),
+				),
+			},
+		},
+	})
+}
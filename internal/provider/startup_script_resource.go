@@ -4,14 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 	"terraform-provider-paperspace/internal/psclient"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -19,8 +22,17 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &startupScriptResource{}
-	_ resource.ResourceWithConfigure = &startupScriptResource{}
+	_ resource.Resource                = &startupScriptResource{}
+	_ resource.ResourceWithConfigure   = &startupScriptResource{}
+	_ resource.ResourceWithImportState = &startupScriptResource{}
+)
+
+// Default operation timeouts, may be overridden with the timeouts block.
+const (
+	defaultStartupScriptCreateTimeout = 5 * time.Minute
+	defaultStartupScriptReadTimeout   = 5 * time.Minute
+	defaultStartupScriptUpdateTimeout = 5 * time.Minute
+	defaultStartupScriptDeleteTimeout = 5 * time.Minute
 )
 
 // NewStartupScriptResource is a helper function to simplify the provider implementation.
@@ -31,16 +43,18 @@ func NewStartupScriptResource() resource.Resource {
 // Maps the resource schema data.
 // State/Plan structure.
 type startupScriptResourceModel struct {
-	Name      types.String `tfsdk:"name"`   // required
-	Script    types.String `tfsdk:"script"` // required
-	IsRunOnce types.Bool   `tfsdk:"is_run_once"`
+	Name        types.String `tfsdk:"name"`   // required
+	Script      types.String `tfsdk:"script"` // required
+	IsRunOnce   types.Bool   `tfsdk:"is_run_once"`
+	Description types.String `tfsdk:"description"`
 
 	// Computed only
 	ID                 types.String `tfsdk:"id"`
-	Description        types.String `tfsdk:"description"`
 	IsEnabled          types.Bool   `tfsdk:"is_enabled"`
 	AssignedMachineIDs types.List   `tfsdk:"assigned_machine_ids"`
 	DtCreated          types.String `tfsdk:"dt_created"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // startupScriptResource is the resource implementation.
@@ -56,7 +70,7 @@ func (r *startupScriptResource) Metadata(_ context.Context, req resource.Metadat
 
 // Define Schema
 // The resource uses the Schema method to define the supported configuration, plan, and state attribute names and types.
-func (r *startupScriptResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *startupScriptResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 
 	// elementType: types.StringType, elements: []attr.Value{}
 
@@ -64,31 +78,26 @@ func (r *startupScriptResource) Schema(_ context.Context, _ resource.SchemaReque
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Startup script resource",
 		Attributes: map[string]schema.Attribute{
-			// TODO: Implement update and remove RequiresReplace plan modifiers
-
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the startup script.",
 				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			// TODO: Consider sensitive or encoded
 			"script": schema.StringAttribute{
-				MarkdownDescription: "The script to run on startup.",
+				MarkdownDescription: "The script to run on startup. Updated in place, so machines the script is assigned to keep it.",
 				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"is_run_once": schema.BoolAttribute{
 				MarkdownDescription: "Whether the script should only run once on first boot or on every boot.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the startup script. Removing it clears the description.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
 			},
 
 			// Computed only
@@ -97,11 +106,6 @@ func (r *startupScriptResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "The description of the startup script.",
-				Computed:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
-			},
 			"is_enabled": schema.BoolAttribute{
 				MarkdownDescription: "Whether the startup script is enabled.",
 				Computed:            true, // Cannot be set during creation, so computed only to simplify
@@ -118,10 +122,17 @@ func (r *startupScriptResource) Schema(_ context.Context, _ resource.SchemaReque
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
-// TODO: Add timeouts https://developer.hashicorp.com/terraform/plugin/framework/resources/timeouts
 // Create a new resource.
 func (r *startupScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan startupScriptResourceModel
@@ -133,12 +144,22 @@ func (r *startupScriptResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultStartupScriptCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Generate API request body from plan and create new startup script
 
 	reqData := psclient.StartupScriptCreateConfig{
-		Name:      plan.Name.ValueString(),   // required
-		Script:    plan.Script.ValueString(), // required
-		IsRunOnce: plan.IsRunOnce.ValueBool(),
+		Name:        plan.Name.ValueString(),   // required
+		Script:      plan.Script.ValueString(), // required
+		IsRunOnce:   plan.IsRunOnce.ValueBool(),
+		Description: plan.Description.ValueString(),
 	}
 
 	// TODO: Save script into state in base64
@@ -146,7 +167,7 @@ func (r *startupScriptResource) Create(ctx context.Context, req resource.CreateR
 	jsonData, _ := json.MarshalIndent(reqData, "", " ")
 	tflog.Info(ctx, "Sending create req data: "+string(jsonData))
 
-	startupScript, err := r.client.CreateStartupScript(reqData)
+	startupScript, err := r.client.CreateStartupScript(ctx, reqData)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating startup script",
@@ -188,11 +209,20 @@ func (r *startupScriptResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultStartupScriptReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed data from Paperspace
-	startupScript, err := r.client.GetStartupScript(state.ID.ValueString())
+	startupScript, err := r.client.GetStartupScript(ctx, state.ID.ValueString())
 	if err != nil {
 		// Avoid error due to 404 status. Resource could be deleted outside provider, so handle this as expected case.
-		if strings.Contains(err.Error(), "status: 404") {
+		if psclient.IsNotFound(err) {
 			tflog.Warn(ctx, fmt.Sprintf("Startup script %s not found, removing from state", state.ID.ValueString()))
 			resp.State.RemoveResource(ctx)
 			return
@@ -233,7 +263,83 @@ func (r *startupScriptResource) Read(ctx context.Context, req resource.ReadReque
 
 // Updates the resource and sets the updated Terraform state on success.
 func (r *startupScriptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// TODO
+	// Fetch the entire plan and prior state
+	var plan, state startupScriptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultStartupScriptUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	startupScriptID := state.ID.ValueString()
+
+	// Generate API request body from plan, send only changed values
+
+	reqData := psclient.StartupScriptUpdateConfig{}
+
+	if !plan.Name.Equal(state.Name) {
+		reqData.Name = plan.Name.ValueString()
+	}
+
+	if !plan.Script.Equal(state.Script) {
+		reqData.Script = plan.Script.ValueString()
+	}
+
+	if !plan.IsRunOnce.Equal(state.IsRunOnce) {
+		reqData.IsRunOnce = plan.IsRunOnce.ValueBoolPointer()
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		reqData.Description = plan.Description.ValueStringPointer()
+	}
+
+	if reqData != (psclient.StartupScriptUpdateConfig{}) {
+		jsonData, _ := json.MarshalIndent(reqData, "", " ")
+		tflog.Info(ctx, "Sending update req data: "+string(jsonData))
+
+		_, err := r.client.UpdateStartupScript(ctx, startupScriptID, reqData)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating startup script",
+				"Could not update startup script, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Fetch updated startup script, including the script body
+	startupScript, err := r.client.GetStartupScript(ctx, startupScriptID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading updated Paperspace startup script",
+			"Could not read Paperspace startup script ID "+startupScriptID+": "+err.Error(),
+		)
+		return
+	}
+
+	assignedMachineIDs, diags := types.ListValueFrom(ctx, types.StringType, startupScript.AssignedMachineIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AssignedMachineIDs = assignedMachineIDs
+	fillStateWithStartupScriptData(&plan, startupScript)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -246,14 +352,18 @@ func (r *startupScriptResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultStartupScriptDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	startupScriptID := state.ID.ValueString()
-	err := r.client.DeleteStartupScript(startupScriptID)
+	err := r.client.DeleteStartupScript(ctx, startupScriptID)
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
-			tflog.Info(ctx, fmt.Sprintf("Startup script %s not found, assuming already deleted", startupScriptID))
-			return
-		}
-
 		resp.Diagnostics.AddError(
 			"Error Deleting startup script",
 			"Could not delete startup script, unexpected error: "+err.Error(),
@@ -262,6 +372,11 @@ func (r *startupScriptResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
+// ImportState imports an existing startup script by ID, all attributes are filled by Read.
+func (r *startupScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *startupScriptResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Add a nil check when handling ProviderData because Terraform
@@ -286,8 +401,15 @@ func (r *startupScriptResource) Configure(_ context.Context, req resource.Config
 
 func fillStateWithStartupScriptData(state *startupScriptResourceModel, startupScript *psclient.StartupScript) {
 	state.Name = types.StringValue(startupScript.Name)
+	if startupScript.Script != nil {
+		state.Script = types.StringValue(*startupScript.Script)
+	}
 	state.IsRunOnce = types.BoolValue(startupScript.IsRunOnce)
-	state.Description = types.StringPointerValue(startupScript.Description)
+	// Missing description is the same as an empty one, so removing it from configuration clears it
+	state.Description = types.StringValue("")
+	if startupScript.Description != nil {
+		state.Description = types.StringValue(*startupScript.Description)
+	}
 	state.IsEnabled = types.BoolValue(startupScript.IsEnabled)
 	state.DtCreated = types.StringValue(startupScript.DtCreated)
 }
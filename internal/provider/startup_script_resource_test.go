@@ -1,3 +1,111 @@
 package provider
 
-// TODO
+import (
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+const testAccStartupScriptResourceName = "paperspace_startup_script.test"
+
+var testAccStartupScriptResourceConfigs = map[string]string{
+	"CreateRead": providerConfig + `
+resource "paperspace_startup_script" "test" {
+  name        = "paperspace-provider-test-CreateRead"
+  script      = "echo 'Hello, World!'"
+  is_run_once = true
+}
+`,
+
+	"UpdateRead": providerConfig + `
+resource "paperspace_startup_script" "test" {
+  name        = "paperspace-provider-test-UpdateRead"
+  script      = "echo 'Hello, Paperspace!'"
+  is_run_once = false
+  description = "Updated in place"
+}
+`,
+
+	"RemoveDescription": providerConfig + `
+resource "paperspace_startup_script" "test" {
+  name        = "paperspace-provider-test-UpdateRead"
+  script      = "echo 'Hello, Paperspace!'"
+  is_run_once = false
+}
+`,
+}
+
+var testAccStartupScriptResourceSteps = []resource.TestStep{
+	{
+		Config: testAccStartupScriptResourceConfigs["CreateRead"],
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccStartupScriptResourceName,
+			map[string]string{
+				"name":        "paperspace-provider-test-CreateRead",
+				"script":      "echo 'Hello, World!'",
+				"is_run_once": "true",
+
+				"id":                     "_any_",
+				"is_enabled":             "_any_",
+				"dt_created":             "_any_",
+				"assigned_machine_ids.#": "0",
+			},
+		)...),
+	},
+	// ImportState testing
+	{
+		ResourceName:      testAccStartupScriptResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+	},
+	// Update and Read testing, the script must not be replaced
+	{
+		Config: testAccStartupScriptResourceConfigs["UpdateRead"],
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(testAccStartupScriptResourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+			testAccStartupScriptResourceName,
+			map[string]string{
+				"name":        "paperspace-provider-test-UpdateRead",
+				"script":      "echo 'Hello, Paperspace!'",
+				"is_run_once": "false",
+				"description": "Updated in place",
+			},
+		)...),
+	},
+	// Removing the description from configuration clears it
+	{
+		Config: testAccStartupScriptResourceConfigs["RemoveDescription"],
+		ConfigPlanChecks: resource.ConfigPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(testAccStartupScriptResourceName, plancheck.ResourceActionUpdate),
+			},
+		},
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr(testAccStartupScriptResourceName, "description", ""),
+		),
+	},
+}
+
+func TestAccStartupScriptResource(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps:                    testAccStartupScriptResourceSteps,
+	})
+}
+
+// Same steps against the fake API, runs offline.
+func TestStartupScriptResource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+		Steps:                    testFakeSteps(fake, testAccStartupScriptResourceSteps),
+	})
+}
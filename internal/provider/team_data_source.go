@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-paperspace/internal/psclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &teamDataSource{}
+	_ datasource.DataSourceWithConfigure = &teamDataSource{}
+)
+
+// NewTeamDataSource is a helper function to simplify the provider implementation.
+func NewTeamDataSource() datasource.DataSource {
+	return &teamDataSource{}
+}
+
+// Allow your data source type to store a reference to the Paperspace client.
+type teamDataSource struct {
+	client *psclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *teamDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+//// Data model types
+
+// teamDataSourceModel maps the data source schema data.
+type teamDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+	MaxMachines types.Int64  `tfsdk:"max_machines"`
+	IsPrivate   types.Bool   `tfsdk:"is_private"`
+	IsUserTeam  types.Bool   `tfsdk:"is_user_team"`
+	IsOwner     types.Bool   `tfsdk:"is_owner"`
+	IsAdmin     types.Bool   `tfsdk:"is_admin"`
+	DtCreated   types.String `tfsdk:"dt_created"`
+}
+
+//// Schema
+
+// Schema defines the schema for the data source.
+func (d *teamDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Team of the provider API key, which owns all resources managed by the provider. " +
+			"Fails if the team differs from `team_id` or `team_namespace` set in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the team.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the team.",
+				Computed:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace (handle) of the team.",
+				Computed:            true,
+			},
+			"max_machines": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of machines the team may have.",
+				Computed:            true,
+			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team is private.",
+				Computed:            true,
+			},
+			"is_user_team": schema.BoolAttribute{
+				MarkdownDescription: "Whether the team is the personal team of the user.",
+				Computed:            true,
+			},
+			"is_owner": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user of the API key owns the team.",
+				Computed:            true,
+			},
+			"is_admin": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user of the API key is an admin of the team.",
+				Computed:            true,
+			},
+			"dt_created": schema.StringAttribute{
+				MarkdownDescription: "Date of the team creation.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *teamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	authSession, err := d.client.Authenticate(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Paperspace Team",
+			err.Error(),
+		)
+		return
+	}
+
+	state := teamDataSourceModel{
+		ID:          types.StringValue(authSession.Team.ID),
+		Namespace:   types.StringValue(authSession.Team.Namespace),
+		MaxMachines: types.Int64Value(authSession.Team.MaxMachines),
+		IsPrivate:   types.BoolValue(authSession.Team.IsPrivate),
+		IsUserTeam:  types.BoolValue(false),
+		IsOwner:     types.BoolValue(false),
+		IsAdmin:     types.BoolValue(false),
+		Name:        types.StringNull(),
+		DtCreated:   types.StringNull(),
+	}
+
+	// Team details and user role are in the membership of the team
+	for _, membership := range authSession.User.TeamMemberships {
+		if membership.Team.ID == authSession.Team.ID {
+			state.Name = types.StringValue(membership.Team.Name)
+			state.IsUserTeam = types.BoolValue(membership.Team.IsUserTeam)
+			state.IsOwner = types.BoolValue(membership.IsOwner)
+			state.IsAdmin = types.BoolValue(membership.IsAdmin)
+			state.DtCreated = types.StringValue(membership.Team.DtCreated)
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *teamDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*psclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *psclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccTeamDataSourceConfig = `
+data "paperspace_team" "test" {}
+`
+
+func TestAccTeamDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccTeamDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+					"data.paperspace_team.test",
+					map[string]string{
+						"id":           "_any_",
+						"namespace":    "_any_",
+						"max_machines": "_any_",
+						"is_owner":     "_any_",
+						"is_admin":     "_any_",
+					},
+				)...),
+			},
+		},
+	})
+}
+
+// Team of the key is checked against the configured one, using a user with research and production teams.
+func TestTeamDataSource(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	fake.AuthSession.User.TeamMemberships = []psclient.TeamMembership{
+		{Team: psclient.Team{ID: "pst0001", Name: "Research", Namespace: "research", DtCreated: "2024-01-01T00:00:00Z"}, IsAdmin: true},
+		{Team: psclient.Team{ID: "pst0002", Name: "Production", Namespace: "production"}, IsOwner: true},
+	}
+	fake.AuthSession.Team = psclient.TeamInfo{ID: "pst0001", Namespace: "research", MaxMachines: 5}
+
+	t.Run("matching team", func(t *testing.T) {
+		t.Setenv("PAPERSPACE_TEAM_NAMESPACE", "research")
+
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: testFakeProviderConfig(fake) + testAccTeamDataSourceConfig,
+					Check: resource.ComposeAggregateTestCheckFunc(genTestCheckFuncs(
+						"data.paperspace_team.test",
+						map[string]string{
+							"id":           "pst0001",
+							"name":         "Research",
+							"namespace":    "research",
+							"max_machines": "5",
+							"is_private":   "false",
+							"is_user_team": "false",
+							"is_owner":     "false",
+							"is_admin":     "true",
+							"dt_created":   "2024-01-01T00:00:00Z",
+						},
+					)...),
+				},
+			},
+		})
+	})
+
+	t.Run("other team", func(t *testing.T) {
+		t.Setenv("PAPERSPACE_TEAM_NAMESPACE", "production")
+
+		resource.UnitTest(t, resource.TestCase{
+			ProtoV6ProviderFactories: testFakeProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      testFakeProviderConfig(fake) + testAccTeamDataSourceConfig,
+					ExpectError: regexp.MustCompile("API key belongs to team research"),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure durationValidator satisfies the validator interface.
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that the string is a positive Go duration, e.g. "30s" or "5m".
+type durationValidator struct{}
+
+func (v durationValidator) Description(_ context.Context) string {
+	return "value must be a positive duration, e.g. \"30s\", \"5m\" or \"1h\""
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && duration <= 0 {
+		err = fmt.Errorf("duration must be positive")
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Attribute %s %s, got %q: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// isDuration returns a validator which ensures the string is a valid positive duration.
+func isDuration() validator.String {
+	return durationValidator{}
+}
+
+// Ensure regexValidator satisfies the validator interface.
+var _ validator.String = regexValidator{}
+
+// regexValidator checks that the string is a valid Go regular expression.
+type regexValidator struct{}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Attribute %s %s, got %q: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// isRegex returns a validator which ensures the string is a valid regular expression.
+func isRegex() validator.String {
+	return regexValidator{}
+}
+
+// Ensure urlValidator satisfies the validator interface.
+var _ validator.String = urlValidator{}
+
+// urlValidator checks that the string is an absolute HTTP or HTTPS URL.
+type urlValidator struct{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "value must be an absolute HTTP or HTTPS URL, e.g. \"https://api.paperspace.com/v1\""
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := checkURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s %s, got %q: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString(), err),
+		)
+	}
+}
+
+// isURL returns a validator which ensures the string is an absolute HTTP or HTTPS URL.
+func isURL() validator.String {
+	return urlValidator{}
+}
+
+// checkURL returns an error if the value is not an absolute HTTP or HTTPS URL.
+// Also used for values set by environment variables, which are not checked by validators.
+func checkURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("host is missing")
+	}
+
+	return nil
+}
@@ -7,7 +7,11 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const HostURL string = "https://api.paperspace.com/v1"
@@ -16,17 +20,48 @@ type Client struct {
 	HostURL     string
 	HTTPClient  *http.Client
 	Token       string
-	AuthSession *AuthSession
-	Context     *context.Context
+	RetryPolicy RetryPolicy
+	EventPolicy EventPolicy
+
+	// Intervals between status checks while waiting for long-running operations
+	EventPollInterval time.Duration
+	StatePollInterval time.Duration
+
+	// Whether to skip loading the auth session before the first API request,
+	// which validates the token, e.g. for API gateways without the auth session endpoint.
+	// Ignored if the team is set, since the team is validated with the auth session.
+	SkipCredentialsValidation bool
+
+	// Team the token must belong to, by ID or namespace. Any team is accepted if both are empty.
+	TeamID        string
+	TeamNamespace string
+
+	// Auth session loaded on first use, see GetAuthSession
+	authSession *AuthSession
+	authMu      sync.Mutex
 }
 
-func (c *Client) GetAuthSession() (*AuthSession, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/auth/session", c.HostURL), nil)
+// GetAuthSession returns the user and team of the token. The session is requested once
+// and reused by later calls, so it may be called freely, e.g. to validate team settings.
+func (c *Client) GetAuthSession(ctx context.Context) (*AuthSession, error) {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if c.authSession != nil {
+		return c.authSession, nil
+	}
+
+	if c.Token == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/auth/session", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	// Sent directly, not through doRequest, since this is the request which checks the credentials
+	body, err := c.sendRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -42,38 +77,111 @@ func (c *Client) GetAuthSession() (*AuthSession, error) {
 		return nil, errors.New("unable to get auth session, possibly invalid token")
 	}
 
+	c.authSession = authSession
+
 	return authSession, nil
 }
 
-func NewClient(host, authToken *string, ctx context.Context) (*Client, error) {
+// NewClient returns a client of the API at host, or the default one if host is nil.
+// No request is sent until the client is used, so it may be created without network access
+// or credentials. The token is validated before the first API request, unless
+// SkipCredentialsValidation is set, and requests fail with ErrMissingCredentials if it's empty.
+func NewClient(host, authToken *string) (*Client, error) {
+	return NewClientWithHTTPClient(&http.Client{Timeout: DefaultRequestTimeout}, host, authToken)
+}
+
+// NewClientWithHTTPClient is like NewClient, but sends requests with the given HTTP client.
+func NewClientWithHTTPClient(httpClient *http.Client, host, authToken *string) (*Client, error) {
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
-		HostURL:    HostURL,
-		Context:    &ctx,
-	}
+		HTTPClient:  httpClient,
+		HostURL:     HostURL,
+		RetryPolicy: DefaultRetryPolicy(),
+		EventPolicy: DefaultEventPolicy(),
 
-	// If authToken not provided, return empty client
-	if authToken == nil {
-		return &c, nil
+		EventPollInterval: DefaultEventPollInterval,
+		StatePollInterval: DefaultStatePollInterval,
 	}
 
 	if host != nil {
 		c.HostURL = *host
 	}
 
-	c.Token = *authToken
+	if authToken != nil {
+		c.Token = *authToken
+	}
+
+	return &c, nil
+}
+
+// Authenticates the client if needed and sends the request, see sendRequest.
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	if c.Token == "" {
+		return nil, ErrMissingCredentials
+	}
+
+	if !c.SkipCredentialsValidation || c.TeamID != "" || c.TeamNamespace != "" {
+		if _, err := c.Authenticate(req.Context()); err != nil {
+			return nil, err
+		}
+	}
 
-	authSession, err := c.GetAuthSession()
+	return c.sendRequest(req)
+}
+
+// Authenticate returns the auth session and checks that the token belongs to the configured team.
+func (c *Client) Authenticate(ctx context.Context) (*AuthSession, error) {
+	authSession, err := c.GetAuthSession(ctx)
 	if err != nil {
+		return nil, fmt.Errorf("unable to validate Paperspace credentials: %w", err)
+	}
+
+	if err := c.checkTeam(authSession); err != nil {
 		return nil, err
 	}
 
-	c.AuthSession = authSession
+	return authSession, nil
+}
 
-	return &c, nil
+// Returns an error if the configured team is not the team of the token.
+func (c *Client) checkTeam(authSession *AuthSession) error {
+	if c.TeamID == "" && c.TeamNamespace == "" {
+		return nil
+	}
+
+	var expected *Team
+	teams := []string{}
+	for i := range authSession.User.TeamMemberships {
+		team := &authSession.User.TeamMemberships[i].Team
+		teams = append(teams, fmt.Sprintf("%s (ID %s, namespace %s)", team.Name, team.ID, team.Namespace))
+
+		if (c.TeamID == "" || team.ID == c.TeamID) && (c.TeamNamespace == "" || team.Namespace == c.TeamNamespace) {
+			expected = team
+		}
+	}
+
+	if expected == nil {
+		configured := c.TeamNamespace
+		switch {
+		case c.TeamID != "" && configured != "":
+			configured += fmt.Sprintf(" (ID %s)", c.TeamID)
+		case c.TeamID != "":
+			configured = "with ID " + c.TeamID
+		}
+
+		return fmt.Errorf("user %s is not a member of team %s, available teams: %s",
+			authSession.User.ID, configured, strings.Join(teams, ", "))
+	}
+
+	if authSession.Team.ID != expected.ID {
+		return fmt.Errorf("the API key belongs to team %s (ID %s), but team %s (ID %s) is configured, use an API key created in team %s",
+			authSession.Team.Namespace, authSession.Team.ID, expected.Namespace, expected.ID, expected.Namespace)
+	}
+
+	return nil
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+// Sends the request with the token, retrying transient failures according to the retry policy.
+func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
 	req.Header.Set("Authorization", "Bearer "+c.Token)
 
 	// Omit Content-Type header if the method is PATCH
@@ -81,20 +189,62 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 		req.Header.Set("Content-Type", "application/json")
 	}
 
+	for attempt := 1; ; attempt++ {
+		res, body, err := c.doAttempt(req)
+
+		// Never retry if the operation was cancelled or timed out
+		canRetry := attempt < c.RetryPolicy.MaxAttempts && req.Context().Err() == nil
+
+		if !canRetry || !isRetryable(req.Method, res, err) {
+			if err != nil {
+				return nil, err
+			}
+
+			if res.StatusCode != http.StatusOK {
+				return nil, newAPIError(req, res, body)
+			}
+
+			return body, nil
+		}
+
+		delay := c.RetryPolicy.delay(attempt, res)
+		var reason string
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = fmt.Sprintf("status %d", res.StatusCode)
+		}
+		tflog.Warn(req.Context(), fmt.Sprintf("%s %s failed (%s), retrying in %s (attempt %d of %d)",
+			req.Method, req.URL.Path, reason, delay, attempt+1, c.RetryPolicy.MaxAttempts))
+
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		// Request body has been consumed by the previous attempt, so it must be recreated
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// Sends the request once and reads the whole response body.
+func (c *Client) doAttempt(req *http.Request) (*http.Response, []byte, error) {
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
-	}
-
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+		return nil, nil, err
 	}
 
-	return body, err
+	return res, body, nil
 }
@@ -0,0 +1,205 @@
+package psclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestNewClient(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	// Credentials are validated on first use
+	if count := fake.CountRequests(http.MethodGet, "/auth/session"); count != 0 {
+		t.Fatalf("expected no auth session request on creation, got %d", count)
+	}
+
+	for range 2 {
+		if _, err := client.GetPrivateNetworks(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if count := fake.CountRequests(http.MethodGet, "/auth/session"); count != 1 {
+		t.Errorf("expected single auth session request, got %d", count)
+	}
+
+	authSession, err := client.GetAuthSession(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authSession.User.ID == "" {
+		t.Fatalf("expected auth session to be loaded, got %+v", authSession)
+	}
+}
+
+func TestNewClientInvalidToken(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	token := "invalid"
+
+	client, err := psclient.NewClient(&fake.URL, &token)
+	if err != nil {
+		t.Fatalf("expected client to be created without validation, got %v", err)
+	}
+
+	_, err = client.GetPrivateNetworks(context.Background())
+	if err == nil {
+		t.Fatal("expected error for invalid token")
+	}
+
+	var apiErr *psclient.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized || apiErr.Path != "/auth/session" {
+		t.Fatalf("expected 401 API error of auth session, got %v", err)
+	}
+
+	if count := fake.CountRequests(http.MethodGet, "/private-networks"); count != 0 {
+		t.Errorf("expected no request with invalid credentials, got %d", count)
+	}
+}
+
+func TestNewClientMissingToken(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+
+	for _, token := range []*string{nil, new(string)} {
+		client, err := psclient.NewClient(&fake.URL, token)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := client.GetPrivateNetworks(context.Background()); !errors.Is(err, psclient.ErrMissingCredentials) {
+			t.Errorf("expected missing credentials error, got %v", err)
+		}
+	}
+
+	if requests := fake.Requests(); len(requests) != 0 {
+		t.Errorf("expected no requests without credentials, got %v", requests)
+	}
+}
+
+func TestSkipCredentialsValidation(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	client.SkipCredentialsValidation = true
+
+	if _, err := client.GetPrivateNetworks(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if count := fake.CountRequests(http.MethodGet, "/auth/session"); count != 0 {
+		t.Errorf("expected no auth session request, got %d", count)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statusCode int
+		wantErr    bool
+		wantCount  int
+	}{
+		{name: "GET retried on 503", method: http.MethodGet, statusCode: http.StatusServiceUnavailable, wantCount: 3},
+		{name: "POST retried on 429", method: http.MethodPost, statusCode: http.StatusTooManyRequests, wantCount: 3},
+		{name: "POST not retried on 503", method: http.MethodPost, statusCode: http.StatusServiceUnavailable, wantErr: true, wantCount: 1},
+		{name: "GET not retried on 400", method: http.MethodGet, statusCode: http.StatusBadRequest, wantErr: true, wantCount: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			client := fake.NewClient(t)
+			ctx := context.Background()
+
+			fake.FailRequests(tt.method, "/private-networks", tt.statusCode, 2)
+
+			var err error
+			if tt.method == http.MethodGet {
+				_, err = client.GetPrivateNetworks(ctx)
+			} else {
+				_, err = client.CreatePrivateNetwork(ctx, psclient.PrivateNetworkCreateConfig{Name: "test", Region: "ny2"})
+			}
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if count := fake.CountRequests(tt.method, "/private-networks"); count != tt.wantCount {
+				t.Errorf("expected %d requests, got %d", tt.wantCount, count)
+			}
+		})
+	}
+}
+
+func TestRetryMaxAttempts(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	client.RetryPolicy.MaxAttempts = 2
+
+	fake.FailRequests(http.MethodGet, "/private-networks", http.StatusBadGateway, 5)
+
+	_, err := client.GetPrivateNetworks(context.Background())
+	if err == nil {
+		t.Fatal("expected error after retries are exhausted")
+	}
+
+	if count := fake.CountRequests(http.MethodGet, "/private-networks"); count != 2 {
+		t.Errorf("expected 2 requests, got %d", count)
+	}
+}
+
+func TestTeamValidation(t *testing.T) {
+	tests := []struct {
+		name          string
+		teamID        string
+		teamNamespace string
+		wantErr       string
+	}{
+		{name: "any team"},
+		{name: "team ID", teamID: "pst0001"},
+		{name: "team namespace", teamNamespace: "research"},
+		{name: "team ID and namespace", teamID: "pst0001", teamNamespace: "research"},
+		{name: "other team of the user", teamNamespace: "production", wantErr: "the API key belongs to team research"},
+		{name: "unknown team", teamID: "pst9999", wantErr: "not a member of team with ID pst9999"},
+		{name: "inconsistent team ID and namespace", teamID: "pst0001", teamNamespace: "production", wantErr: "not a member of team production (ID pst0001)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			fake.AuthSession.User.TeamMemberships = []psclient.TeamMembership{
+				{Team: psclient.Team{ID: "pst0001", Name: "Research", Namespace: "research"}, IsAdmin: true},
+				{Team: psclient.Team{ID: "pst0002", Name: "Production", Namespace: "production"}},
+			}
+			fake.AuthSession.Team = psclient.TeamInfo{ID: "pst0001", Namespace: "research", MaxMachines: 5}
+
+			client := fake.NewClient(t)
+			client.TeamID = tt.teamID
+			client.TeamNamespace = tt.teamNamespace
+
+			// Team is validated even if credentials validation is skipped
+			client.SkipCredentialsValidation = true
+
+			_, err := client.GetPrivateNetworks(context.Background())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if count := fake.CountRequests(http.MethodGet, "/private-networks"); count != 0 {
+				t.Errorf("expected no request with mismatched team, got %d", count)
+			}
+		})
+	}
+}
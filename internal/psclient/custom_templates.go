@@ -1,16 +1,119 @@
 package psclient
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"sort"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func (c *Client) GetCustomTemplates() (*[]CustomTemplate, error) {
+// Creates a template from the machine. Template can be created only from a stopped machine,
+// so the machine is stopped first if it's running, and started again on return, whether the creation succeeded or not.
+// If the template is created, but waiting for it fails, the created template is returned along with the error.
+// If only starting the machine fails, the template is returned along with a *MachineStartError.
+func (c *Client) CreateCustomTemplate(ctx context.Context, customTemplateCreateConfig CustomTemplateCreateConfig) (customTemplate *CustomTemplate, err error) {
+	machineID := customTemplateCreateConfig.MachineID
+
+	machine, err := c.GetMachine(ctx, machineID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get machine %s: %w", machineID, err)
+	}
+
+	if machine.State == MachineStateReady {
+		// Restore the power state, so the machine doesn't drift from its configuration.
+		// Cancellation of the creation must not prevent it, so it has its own wait timeout.
+		defer func() {
+			tflog.Info(ctx, fmt.Sprintf("Starting machine '%s' after creating a template", machineID))
+			startErr := c.ManageMachineState(context.WithoutCancel(ctx), machineID, MachineStateReady)
+			if startErr == nil {
+				return
+			}
+
+			if err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Could not start machine '%s' after failed template creation: %s", machineID, startErr))
+				return
+			}
+			err = &MachineStartError{MachineID: machineID, Err: startErr}
+		}()
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Stopping machine '%s' before creating a template", machineID))
+	err = c.ManageMachineState(ctx, machineID, MachineStateOff)
+	if err != nil {
+		return nil, fmt.Errorf("could not stop machine %s: %w", machineID, err)
+	}
+
+	rb, err := json.Marshal(customTemplateCreateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/custom-templates", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "POST response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	customTemplate = &CustomTemplate{}
+	err = json.Unmarshal(res, customTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Template is baked by machine events, wait for them and for the template to be returned by API
+	tflog.Info(ctx, fmt.Sprintf("Waiting for machine '%s' events to complete", machineID))
+	err = c.waitForMachineEvents(ctx, machineID)
+	if err != nil {
+		return customTemplate, err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting for template '%s' to be created", customTemplate.ID))
+	createdTemplate, err := c.waitForCustomTemplate(ctx, customTemplate.ID)
+	if err != nil {
+		return customTemplate, err
+	}
+
+	return createdTemplate, nil
+}
+
+func (c *Client) GetCustomTemplate(ctx context.Context, id string) (*CustomTemplate, error) {
+	url := fmt.Sprintf("%s/custom-templates/%s", c.HostURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	customTemplate := CustomTemplate{}
+	err = json.Unmarshal(resBody, &customTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customTemplate, nil
+}
+
+func (c *Client) GetCustomTemplates(ctx context.Context) (*[]CustomTemplate, error) {
 	allItems := []CustomTemplate{}
 	params := map[string]string{}
 
-	err := fetchAllItems(c, &allItems, "custom-templates", params)
+	err := fetchAllItems(ctx, c, &allItems, "custom-templates", params)
 	if err != nil {
 		return nil, err
 	}
@@ -33,6 +136,83 @@ func (c *Client) GetCustomTemplates() (*[]CustomTemplate, error) {
 	return &allItems, nil
 }
 
+func (c *Client) UpdateCustomTemplate(ctx context.Context, id string, customTemplateUpdateConfig CustomTemplateUpdateConfig) (*CustomTemplate, error) {
+	rb, err := json.Marshal(customTemplateUpdateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/custom-templates/%s", c.HostURL, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "PUT response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	customTemplate := CustomTemplate{}
+	err = json.Unmarshal(res, &customTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &customTemplate, nil
+}
+
+func (c *Client) DeleteCustomTemplate(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/custom-templates/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+
+	if err != nil {
+		if IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Custom template %s not found, assuming already deleted", id))
+			return nil
+		}
+		// Other errors that are not 404
+		return err
+	}
+
+	tflog.Info(ctx, "DELETE response body: "+string(res))
+
+	return nil
+}
+
+// Polls the template until it's returned by API.
+// Machine types listed as available depend on the region capacity, not on the template, so they aren't waited for.
+func (c *Client) waitForCustomTemplate(ctx context.Context, id string) (*CustomTemplate, error) {
+	ctx, cancel := withWaitTimeout(ctx)
+	defer cancel()
+
+	subject := fmt.Sprintf("template %s to be created", id)
+
+	for {
+		customTemplate, err := c.GetCustomTemplate(ctx, id)
+		if err == nil {
+			return customTemplate, nil
+		}
+
+		if !IsNotFound(err) {
+			if ctx.Err() != nil {
+				return nil, waitError(ctx, subject)
+			}
+			return nil, fmt.Errorf("failed to get template %s: %w", id, err)
+		}
+
+		if sleepContext(ctx, c.StatePollInterval) != nil {
+			return nil, waitError(ctx, subject)
+		}
+	}
+}
+
 func sortCustomTemplates(templates []CustomTemplate, sortBy string) error {
 	switch sortBy {
 	case "ID":
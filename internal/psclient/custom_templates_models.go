@@ -17,3 +17,12 @@ type CustomTemplate struct {
 	DtCreated             string                 `json:"dtCreated"`
 	DtDeleted             *string                `json:"dtDeleted"` // Nullable
 }
+
+type CustomTemplateCreateConfig struct {
+	Name      string `json:"name"`      // required
+	MachineID string `json:"machineId"` // required
+}
+
+type CustomTemplateUpdateConfig struct {
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,189 @@
+package psclient_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestCreateCustomTemplate(t *testing.T) {
+	// Template can be created only from stopped machine, which is started again afterwards if it was running
+	tests := []struct {
+		name          string
+		startOnCreate bool
+		wantState     string
+		wantStops     int
+	}{
+		{name: "stopped", startOnCreate: false, wantState: psclient.MachineStateOff, wantStops: 0},
+		{name: "started", startOnCreate: true, wantState: psclient.MachineStateReady, wantStops: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			client := fake.NewClient(t)
+			ctx := context.Background()
+
+			config := testMachineCreateConfig("test")
+			config.StartOnCreate = tt.startOnCreate
+
+			machine, err := client.CreateMachine(ctx, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			customTemplate, err := client.CreateCustomTemplate(ctx, psclient.CustomTemplateCreateConfig{Name: "test", MachineID: machine.ID})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if state := fake.Machine(machine.ID).State; state != tt.wantState {
+				t.Errorf("expected machine state %s, got %s", tt.wantState, state)
+			}
+			if stops := fake.CountRequests("PATCH", "/machines/"+machine.ID+"/stop"); stops != tt.wantStops {
+				t.Errorf("expected %d machine stops, got %d", tt.wantStops, stops)
+			}
+
+			// Template is returned once it's baked
+			if len(customTemplate.AvailableMachineTypes) == 0 || !customTemplate.AvailableMachineTypes[0].IsAvailable {
+				t.Errorf("expected available machine type, got %+v", customTemplate.AvailableMachineTypes)
+			}
+
+			if customTemplate.ParentMachineID != machine.ID {
+				t.Errorf("expected parent machine %s, got %s", machine.ID, customTemplate.ParentMachineID)
+			}
+		})
+	}
+}
+
+func TestCreateCustomTemplateEventError(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	config := testMachineCreateConfig("test")
+	config.StartOnCreate = true
+
+	machine, err := client.CreateMachine(ctx, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := "disk image failed"
+	fake.ScriptEvents("template", psclienttest.EventStep{State: "error", Error: &message, Finished: true})
+
+	customTemplate, err := client.CreateCustomTemplate(ctx, psclient.CustomTemplateCreateConfig{Name: "test", MachineID: machine.ID})
+	if err == nil || !strings.Contains(err.Error(), message) {
+		t.Fatalf("expected event error, got %v", err)
+	}
+
+	// Created template is returned, so the caller can keep track of it
+	if customTemplate == nil || customTemplate.ID == "" {
+		t.Fatal("expected partially created template to be returned")
+	}
+
+	// Machine is started again on failure too
+	if state := fake.Machine(machine.ID).State; state != psclient.MachineStateReady {
+		t.Errorf("expected machine state %s, got %s", psclient.MachineStateReady, state)
+	}
+}
+
+// Template is usable even if the machine can't be started again, so it's returned with a distinct error.
+func TestCreateCustomTemplateStartError(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	config := testMachineCreateConfig("test")
+	config.StartOnCreate = true
+
+	machine, err := client.CreateMachine(ctx, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake.FailRequests(http.MethodPatch, "/machines/"+machine.ID+"/start", http.StatusBadRequest, 1)
+
+	customTemplate, err := client.CreateCustomTemplate(ctx, psclient.CustomTemplateCreateConfig{Name: "test", MachineID: machine.ID})
+
+	var startErr *psclient.MachineStartError
+	if !errors.As(err, &startErr) || startErr.MachineID != machine.ID {
+		t.Fatalf("expected machine start error, got %v", err)
+	}
+
+	if customTemplate == nil || customTemplate.ID == "" {
+		t.Fatal("expected created template to be returned")
+	}
+}
+
+// Available machine types depend on the region capacity, so they must not block the creation.
+func TestCreateCustomTemplateUnavailableMachineType(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake.SetMachineTypeAvailable("C2", false)
+
+	customTemplate, err := client.CreateCustomTemplate(ctx, psclient.CustomTemplateCreateConfig{Name: "test", MachineID: machine.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(customTemplate.AvailableMachineTypes) == 0 || customTemplate.AvailableMachineTypes[0].IsAvailable {
+		t.Errorf("expected unavailable machine type, got %+v", customTemplate.AvailableMachineTypes)
+	}
+}
+
+func TestCustomTemplateUpdateDelete(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	customTemplate, err := client.CreateCustomTemplate(ctx, psclient.CustomTemplateCreateConfig{Name: "test", MachineID: machine.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := client.UpdateCustomTemplate(ctx, customTemplate.ID, psclient.CustomTemplateUpdateConfig{Name: "renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "renamed" {
+		t.Errorf("expected template to be renamed, got %s", updated.Name)
+	}
+
+	customTemplates, err := client.GetCustomTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*customTemplates) != 1 {
+		t.Errorf("expected 1 template, got %d", len(*customTemplates))
+	}
+
+	for range 2 {
+		if err := client.DeleteCustomTemplate(ctx, customTemplate.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if count := fake.CountRequests(http.MethodDelete, "/custom-templates/"+customTemplate.ID); count != 2 {
+		t.Errorf("expected 2 delete requests, got %d", count)
+	}
+}
@@ -0,0 +1,109 @@
+package psclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrMissingCredentials is returned for API requests of a client created without a token.
+var ErrMissingCredentials = errors.New("no Paperspace API key is configured, set the api_key provider attribute or the PAPERSPACE_API_KEY environment variable")
+
+// APIError is returned for any Paperspace API response with a non-200 status.
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Method     string // HTTP method of the failed request
+	Path       string // URL path of the failed request
+	Code       string // Paperspace error code, if provided by the API
+	Message    string // Paperspace error message, if provided by the API
+	RequestID  string // Value of the X-Request-Id response header, if any
+	Body       string // Raw response body
+}
+
+// MachineStartError is returned when an operation succeeded, but the machine it had to stop
+// could not be started again.
+type MachineStartError struct {
+	MachineID string
+	Err       error
+}
+
+func (e *MachineStartError) Error() string {
+	return fmt.Sprintf("could not start machine %s: %s", e.MachineID, e.Err)
+}
+
+func (e *MachineStartError) Unwrap() error {
+	return e.Err
+}
+
+// Error body returned by the API. Depending on the endpoint, the message is in "message" or "error" field.
+type apiErrorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, http.StatusText(e.StatusCode))
+
+	switch {
+	case e.Message != "":
+		msg += ": " + e.Message
+	case e.Body != "":
+		msg += ": " + e.Body
+	}
+
+	if e.Code != "" {
+		msg += fmt.Sprintf(" (code: %s)", e.Code)
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request id: %s)", e.RequestID)
+	}
+
+	return msg
+}
+
+// Builds APIError from the failed request response.
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		RequestID:  res.Header.Get("X-Request-Id"),
+		Body:       strings.TrimSpace(string(body)),
+	}
+
+	// Body is not always JSON (e.g. errors from the load balancer), so ignore parsing errors
+	errBody := apiErrorBody{}
+	if json.Unmarshal(body, &errBody) == nil {
+		apiErr.Code = errBody.Code
+		apiErr.Message = errBody.Message
+		if apiErr.Message == "" {
+			apiErr.Message = errBody.Error
+		}
+	}
+
+	return apiErr
+}
+
+// IsNotFound returns true if err is an APIError with 404 status.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsRateLimited returns true if err is an APIError with 429 status.
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsConflict returns true if err is an APIError with 409 status.
+func IsConflict(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
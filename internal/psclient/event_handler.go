@@ -1,35 +1,56 @@
 package psclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// Returns number of each found machine event type (name).
-func (c *Client) GetMachineEventsStateStat() (map[string]int, error) {
+// Returns machine events of the team, or of the given machine only if machineID is not empty.
+func (c *Client) GetMachineEvents(ctx context.Context, machineID string) (*[]Event, error) {
 	allItems := []Event{}
-	stat := map[string]int{}
 	params := map[string]string{}
 
-	err := fetchAllItems(c, &allItems, "machine-events", params)
+	if machineID != "" {
+		params["machineId"] = machineID
+	}
+
+	err := fetchAllItems(ctx, c, &allItems, "machine-events", params)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, event := range allItems {
-		stat[event.Name]++
+	return &allItems, nil
+}
+
+// Returns number of each found machine event type (name).
+func (c *Client) GetMachineEventsStateStat(ctx context.Context) (map[string]int, error) {
+	allItems, err := c.GetMachineEvents(ctx, "")
+	if err != nil {
+		return nil, err
 	}
 
-	stat["_totalEventsProcessed"] = len(allItems)
+	stat := MachineEventsStateStat(*allItems)
+	stat["_totalEventsProcessed"] = len(*allItems)
 
 	return stat, nil
 }
 
-func (c *Client) waitForEvent(eventID string) error {
+// Returns number of each machine event type (name) in the given events.
+func MachineEventsStateStat(events []Event) map[string]int {
+	stat := map[string]int{}
+
+	for _, event := range events {
+		stat[event.Name]++
+	}
+
+	return stat
+}
+
+func (c *Client) waitForEvent(ctx context.Context, eventID string) error {
 	// The "state": "error" typically indicates an issue during the execution of a specific action on the machine (like a resource update).
 	// However, if the "error" field is "null", it could mean the API did not capture any specific error details.
 	// In these cases, "state": "error" may be reporting a transient issue that did not prevent successful completion,
@@ -39,28 +60,32 @@ func (c *Client) waitForEvent(eventID string) error {
 	// which is an additional hint that the operation likely completed despite the error state.
 	// This detail can help confirm that the event doesnt need further attention.
 	//
-	// Given these points, the following logic should be implemented:
-	//  - Ignore "state": "error" when "error": null and the action completed as expected without user intervention.
-	//  - Monitor for recurring "state": "error" entries, as this could indicate underlying issues even if individual operations appear to succeed.
+	// Given these points, such events are handled according to the event policy:
+	//  - Ignore "state": "error" when "error": null, if NullErrorAsSuccess is set.
+	//  - Otherwise fail after MaxConsecutiveErrors polls in such state, so recurring errors are not ignored.
 
 	url := fmt.Sprintf("%s/machine-events/%s", c.HostURL, eventID)
 
 	var event Event
-	pollInterval := 5 * time.Second
-	timeout := 30 * time.Minute
 
-	// Create a timer for the timeout
-	timer := time.After(timeout)
+	policy := c.eventPolicy(ctx)
+	consecutiveErrors := 0
+
+	ctx, cancel := withWaitTimeout(ctx)
+	defer cancel()
 
 	// Poll with interval until event is done or error occurs
 	for {
 		// Make the request
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 		if err != nil {
 			return err
 		}
 		resp, err := c.doRequest(req)
 		if err != nil {
+			if ctx.Err() != nil {
+				return waitError(ctx, "event "+eventID)
+			}
 			return err
 		}
 
@@ -85,37 +110,49 @@ func (c *Client) waitForEvent(eventID string) error {
 
 		// There is a case, when {"state": "error", "error": null, "dtFinished": null}
 		if event.State == "error" {
-			return fmt.Errorf("unknown error during event %s processing", eventID)
+			if policy.NullErrorAsSuccess {
+				tflog.Warn(ctx, fmt.Sprintf("Event %s is in error state without details, treating it as completed", eventID))
+				return nil
+			}
+
+			consecutiveErrors++
+			if consecutiveErrors >= policy.MaxConsecutiveErrors {
+				return fmt.Errorf("unknown error during event %s processing", eventID)
+			}
+		} else {
+			consecutiveErrors = 0
 		}
 
 		// Wait for either the polling interval or the timeout
-		select {
-		case <-time.After(pollInterval):
-		case <-timer:
-			return fmt.Errorf("timeout reached while waiting for event %s", eventID)
+		if sleepContext(ctx, c.EventPollInterval) != nil {
+			return waitError(ctx, "event "+eventID)
 		}
 	}
 }
 
-func (c *Client) waitForMachineEvents(machineID string) error {
-	// TODO: Add option to choose which events to ignore or wait
-	allItems := []Event{}
-	params := map[string]string{"machineId": machineID}
+// Waits for pending events of the machine, which are selected by the event policy.
+func (c *Client) waitForMachineEvents(ctx context.Context, machineID string) error {
+	policy := c.eventPolicy(ctx)
 
-	err := fetchAllItems(c, &allItems, "machine-events", params)
+	allItems, err := c.GetMachineEvents(ctx, machineID)
 	if err != nil {
 		return err
 	}
 
-	for _, event := range allItems {
+	for _, event := range *allItems {
 		// Skip 'finished' events, so nothing to wait
 		if event.DtFinished != nil {
 			continue
 		}
 
-		tflog.Info(*c.Context, fmt.Sprintf("Waiting for machine event '%s' to complete, event id: %s", event.Name, event.ID))
+		if !policy.shouldWait(event.Name) {
+			tflog.Info(ctx, fmt.Sprintf("Skipping machine event '%s' by event policy, event id: %s", event.Name, event.ID))
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Waiting for machine event '%s' to complete, event id: %s", event.Name, event.ID))
 
-		err := c.waitForEvent(event.ID)
+		err := c.waitForEvent(ctx, event.ID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,95 @@
+package psclient_test
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestEventPolicyNullError(t *testing.T) {
+	// Event reports error state without details until it's finished
+	nullErrorScript := []psclienttest.EventStep{
+		{State: "in progress"},
+		{State: "error"},
+		{State: "error"},
+		{State: "done", Finished: true},
+	}
+
+	tests := []struct {
+		name    string
+		policy  psclient.EventPolicy
+		wantErr bool
+	}{
+		{name: "default", policy: psclient.DefaultEventPolicy(), wantErr: true},
+		{name: "null error as success", policy: psclient.EventPolicy{NullErrorAsSuccess: true, MaxConsecutiveErrors: 1}},
+		{name: "tolerated consecutive errors", policy: psclient.EventPolicy{MaxConsecutiveErrors: 3}},
+		{name: "too many consecutive errors", policy: psclient.EventPolicy{MaxConsecutiveErrors: 2}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			client := fake.NewClient(t)
+
+			machine, err := client.CreateMachine(context.Background(), testMachineCreateConfig("test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fake.ScriptEvents("update", nullErrorScript...)
+
+			ctx := psclient.WithEventPolicy(context.Background(), tt.policy)
+			err = client.UpdateMachine(ctx, machine.ID, psclient.MachineUpdateConfig{Name: "renamed"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestEventPolicyIgnore(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	machine, err := client.CreateMachine(context.Background(), testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update event never finishes, so it must not be waited for
+	fake.ScriptEvents("update", psclienttest.EventStep{State: "in progress"})
+
+	ctx := psclient.WithEventPolicy(context.Background(), psclient.EventPolicy{Ignore: []string{"update"}, MaxConsecutiveErrors: 1})
+	if err := client.UpdateMachine(ctx, machine.ID, psclient.MachineUpdateConfig{Name: "renamed"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetMachineEventsStateStat(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	for _, name := range []string{"first", "second"} {
+		config := testMachineCreateConfig(name)
+		config.StartOnCreate = true
+
+		if _, err := client.CreateMachine(ctx, config); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stat, err := client.GetMachineEventsStateStat(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]int{"create": 2, "start": 2, "_totalEventsProcessed": 4}
+	for name, count := range want {
+		if stat[name] != count {
+			t.Errorf("expected %d %s events, got %d", count, name, stat[name])
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package psclient
+
+import (
+	"context"
+	"slices"
+)
+
+// EventPolicy describes which machine events are waited for and how ambiguous event states are handled.
+type EventPolicy struct {
+	WaitFor []string // Names of events to wait for, e.g. "start". All pending events are waited for if empty
+	Ignore  []string // Names of events which are never waited for, takes precedence over WaitFor
+
+	// Whether {"state": "error", "error": null} is treated as successful completion.
+	// Such events usually report a transient issue which did not prevent the operation.
+	NullErrorAsSuccess bool
+
+	// Number of consecutive polls in error state without error details, after which the event is considered failed.
+	// Only used when NullErrorAsSuccess is false.
+	MaxConsecutiveErrors int
+}
+
+// DefaultEventPolicy returns the policy used by clients created with NewClient.
+// It waits for all pending events and fails on the first error state.
+func DefaultEventPolicy() EventPolicy {
+	return EventPolicy{
+		NullErrorAsSuccess:   false,
+		MaxConsecutiveErrors: 1,
+	}
+}
+
+type eventPolicyContextKey struct{}
+
+// WithEventPolicy returns context which overrides the client event policy for operations called with it.
+func WithEventPolicy(ctx context.Context, policy EventPolicy) context.Context {
+	return context.WithValue(ctx, eventPolicyContextKey{}, policy)
+}
+
+// Returns event policy of the operation, set by WithEventPolicy, or the client one.
+func (c *Client) eventPolicy(ctx context.Context) EventPolicy {
+	if policy, ok := ctx.Value(eventPolicyContextKey{}).(EventPolicy); ok {
+		return policy
+	}
+	return c.EventPolicy
+}
+
+// Returns whether the event with the given name should be waited for.
+func (p EventPolicy) shouldWait(eventName string) bool {
+	if slices.Contains(p.Ignore, eventName) {
+		return false
+	}
+	return len(p.WaitFor) == 0 || slices.Contains(p.WaitFor, eventName)
+}
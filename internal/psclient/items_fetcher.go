@@ -1,9 +1,11 @@
 package psclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 type ItemsResponse[T any] struct {
@@ -12,22 +14,22 @@ type ItemsResponse[T any] struct {
 	HasMore  bool   `json:"hasMore"`
 }
 
-func fetchAllItems[T any](c *Client, allItems *[]T, path string, params map[string]string) error {
+func fetchAllItems[T any](ctx context.Context, c *Client, allItems *[]T, path string, params map[string]string) error {
 	nextPage := ""
 	hasMore := true
 	params["limit"] = "120"
 
 	for hasMore {
 		// Build the request URL, append nextPage if it's not empty
-		url := fmt.Sprintf("%s/%s", c.HostURL, path)
+		reqURL := fmt.Sprintf("%s/%s", c.HostURL, path)
 
 		if nextPage != "" {
 			params["after"] = nextPage
 		}
 
-		url = fmt.Sprintf("%s?%s", url, buildQueryString(params)) // Custom request arguments
+		reqURL = fmt.Sprintf("%s?%s", reqURL, buildQueryString(params)) // Custom request arguments
 
-		req, err := http.NewRequest("GET", url, nil)
+		req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
 		if err != nil {
 			return err
 		}
@@ -56,10 +58,11 @@ func fetchAllItems[T any](c *Client, allItems *[]T, path string, params map[stri
 }
 
 // Helper function to build the query string from a map of parameters.
+// Values are escaped and parameters are sorted by name, so the same parameters always give the same URL.
 func buildQueryString(params map[string]string) string {
-	q := ""
+	q := url.Values{}
 	for k, v := range params {
-		q += fmt.Sprintf("%s=%s&", k, v)
+		q.Set(k, v)
 	}
-	return q[:len(q)-1] // Remove trailing '&'
+	return q.Encode()
 }
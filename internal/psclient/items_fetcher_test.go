@@ -0,0 +1,93 @@
+package psclient_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestFetchAllItemsPagination(t *testing.T) {
+	tests := []struct {
+		count     int
+		wantPages int
+	}{
+		{count: 0, wantPages: 1},
+		{count: 1, wantPages: 1},
+		{count: psclienttest.DefaultPageSize, wantPages: 1},
+		{count: 5, wantPages: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d items", tt.count), func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			client := fake.NewClient(t)
+			ctx := context.Background()
+
+			for i := 0; i < tt.count; i++ {
+				_, err := client.CreatePrivateNetwork(ctx, psclient.PrivateNetworkCreateConfig{Name: fmt.Sprintf("test-%d", i), Region: "ny2"})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			privateNetworks, err := client.GetPrivateNetworks(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(*privateNetworks) != tt.count {
+				t.Fatalf("expected %d items, got %d", tt.count, len(*privateNetworks))
+			}
+
+			// Items must be complete and in order, without duplicates from overlapping pages
+			for i, privateNetwork := range *privateNetworks {
+				if want := fmt.Sprintf("test-%d", i); privateNetwork.Name != want {
+					t.Errorf("expected item %d to be %s, got %s", i, want, privateNetwork.Name)
+				}
+			}
+
+			if pages := fake.CountRequests(http.MethodGet, "/private-networks"); pages != tt.wantPages {
+				t.Errorf("expected %d pages, got %d", tt.wantPages, pages)
+			}
+		})
+	}
+}
+
+func TestFetchAllItemsQuery(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			fmt.Fprint(w, `{"items":[],"nextPage":"page 2","hasMore":true}`)
+		} else {
+			fmt.Fprint(w, `{"items":[],"hasMore":false}`)
+		}
+	}))
+	defer server.Close()
+
+	token := "token"
+	client, err := psclient.NewClientWithHTTPClient(server.Client(), &server.URL, &token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SkipCredentialsValidation = true
+
+	if _, err := client.GetSnapshots(context.Background(), "psm0001&limit=1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Parameters are escaped and sorted, so identical requests have identical URLs
+	expected := []string{
+		"limit=120&machineId=psm0001%26limit%3D1",
+		"after=page+2&limit=120&machineId=psm0001%26limit%3D1",
+	}
+	if fmt.Sprint(queries) != fmt.Sprint(expected) {
+		t.Errorf("expected queries %q, got %q", expected, queries)
+	}
+}
@@ -0,0 +1,63 @@
+package psclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func (c *Client) GetMachineAccessors(ctx context.Context, machineID string) (*[]MachineAccessor, error) {
+	allItems := []MachineAccessor{}
+	params := map[string]string{}
+
+	err := fetchAllItems(ctx, c, &allItems, fmt.Sprintf("machines/%s/accessors", machineID), params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &allItems, nil
+}
+
+func (c *Client) AddMachineAccessor(ctx context.Context, machineID string, userID string) error {
+	rb, err := json.Marshal(MachineAccessorCreateConfig{UserID: userID})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/machines/%s/accessors", c.HostURL, machineID), strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+	tflog.Info(ctx, "POST response body: "+string(res))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveMachineAccessor(ctx context.Context, machineID string, userID string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/machines/%s/accessors/%s", c.HostURL, machineID, userID), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+	if err != nil {
+		if IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Accessor %s of machine %s not found, assuming already removed", userID, machineID))
+			return nil
+		}
+		return err
+	}
+
+	tflog.Info(ctx, "DELETE response body: "+string(res))
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package psclient
+
+// MachineAccessorUser represents the user who has access to the machine.
+type MachineAccessorUser struct {
+	ID        string `json:"id"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+}
+
+type MachineAccessor struct {
+	User      MachineAccessorUser `json:"user"`
+	DtCreated string              `json:"dtCreated"`
+}
+
+type MachineAccessorCreateConfig struct {
+	UserID string `json:"userId"`
+}
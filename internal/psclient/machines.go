@@ -1,11 +1,12 @@
 package psclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -15,24 +16,32 @@ const (
 	MachineStateOff   string = "off"
 )
 
-func (c *Client) CreateMachine(machineCreateConfig MachineCreateConfig) (*Machine, error) {
-	// TODO: Handle "Get machine availability"
-	// https://docs.digitalocean.com/reference/paperspace/pspace/api-reference/#operation/machineAvailability-list
-	// Throw an error if machine is not available
+// Creates the machine and waits for it to be created and started, if requested.
+// If the machine is created, but waiting fails, the created machine is returned along with the error,
+// so the caller can keep track of it.
+func (c *Client) CreateMachine(ctx context.Context, machineCreateConfig MachineCreateConfig) (*Machine, error) {
+	// Fail early, so unavailable machine is not half-created.
+	// Availability is only a hint, so if it can't be checked, the API decides on creation.
+	availability, err := c.GetMachineAvailability(ctx, machineCreateConfig.Region, machineCreateConfig.MachineType)
+	if err != nil {
+		tflog.Warn(ctx, "Could not check machine availability, creating the machine anyway: "+err.Error())
+	} else if !availability.Available {
+		return nil, fmt.Errorf("machine type %s is not available in region %s", machineCreateConfig.MachineType, machineCreateConfig.Region)
+	}
 
 	rb, err := json.Marshal(machineCreateConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/machines", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/machines", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
 	res, err := c.doRequest(req)
 
-	tflog.Info(*c.Context, "POST response body: "+string(res))
+	tflog.Info(ctx, "POST response body: "+string(res))
 
 	if err != nil {
 		return nil, err
@@ -47,35 +56,63 @@ func (c *Client) CreateMachine(machineCreateConfig MachineCreateConfig) (*Machin
 
 	// WaitForEvent Section
 
-	tflog.Info(*c.Context, fmt.Sprintf("Waiting for machine event '%s' to complete, event id: %s", mashineResponse.Event.Name, mashineResponse.Event.ID))
-	err = c.waitForEvent(mashineResponse.Event.ID)
+	tflog.Info(ctx, fmt.Sprintf("Waiting for machine event '%s' to complete, event id: %s", mashineResponse.Event.Name, mashineResponse.Event.ID))
+	err = c.waitForEvent(ctx, mashineResponse.Event.ID)
 	if err != nil {
-		return nil, err
+		return &mashineResponse.Data, err
 	}
 
 	if machineCreateConfig.StartOnCreate {
-		// Wait for machine to start
-		tflog.Info(*c.Context, fmt.Sprintf("Waiting for machine '%s' to start", mashineResponse.Data.ID))
+		// Wait for machine to start, failed start event would leave it starting forever
+		tflog.Info(ctx, fmt.Sprintf("Waiting for machine '%s' to start", mashineResponse.Data.ID))
 
-		err = c.waitForMachineState(mashineResponse.Data.ID, "ready", 30*time.Minute, 10*time.Second)
+		err = c.waitForMachineEvents(ctx, mashineResponse.Data.ID)
 		if err != nil {
-			// TODO: Handle situation when machine is created but could not start
+			return &mashineResponse.Data, err
+		}
 
-			// log.Fatalf("Error waiting for machine state: %v", err)
-			return nil, err
+		err = c.waitForMachineState(ctx, mashineResponse.Data.ID, MachineStateReady)
+		if err != nil {
+			return &mashineResponse.Data, err
 		}
 	}
 
 	// Fetch and return the created machine
-	machine, err := c.GetMachine(mashineResponse.Data.ID)
+	machine, err := c.GetMachine(ctx, mashineResponse.Data.ID)
 	if err != nil {
-		return nil, err
+		return &mashineResponse.Data, err
 	}
 
 	return machine, nil
 }
 
-func (c *Client) UpdateMachine(machineID string, machineUpdateConfig MachineUpdateConfig) error {
+// Returns whether machines of the given type can be created in the region right now.
+// https://docs.digitalocean.com/reference/paperspace/pspace/api-reference/#operation/machineAvailability-list
+func (c *Client) GetMachineAvailability(ctx context.Context, region string, machineType string) (*MachineAvailability, error) {
+	params := url.Values{}
+	params.Set("region", region)
+	params.Set("machineType", machineType)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/machines/availability?%s", c.HostURL, params.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := MachineAvailability{}
+	err = json.Unmarshal(body, &availability)
+	if err != nil {
+		return nil, err
+	}
+
+	return &availability, nil
+}
+
+func (c *Client) UpdateMachine(ctx context.Context, machineID string, machineUpdateConfig MachineUpdateConfig) error {
 	rb, err := json.Marshal(machineUpdateConfig)
 	if err != nil {
 		return err
@@ -85,17 +122,17 @@ func (c *Client) UpdateMachine(machineID string, machineUpdateConfig MachineUpda
 	if err != nil {
 		return fmt.Errorf("invalid JSON: %v", err)
 	} else if isEmpty {
-		tflog.Info(*c.Context, "PUT request body is empty, nothing to update")
+		tflog.Info(ctx, "PUT request body is empty, nothing to update")
 		return nil
 	}
 
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/machines/%s", c.HostURL, machineID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/machines/%s", c.HostURL, machineID), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
 
 	res, err := c.doRequest(req)
-	tflog.Info(*c.Context, "PUT response body: "+string(res))
+	tflog.Info(ctx, "PUT response body: "+string(res))
 	if err != nil {
 		return err
 	}
@@ -108,8 +145,8 @@ func (c *Client) UpdateMachine(machineID string, machineUpdateConfig MachineUpda
 	}
 
 	// Wait for events to finish
-	tflog.Info(*c.Context, "Waiting for machine events to complete...")
-	err = c.waitForMachineEvents(machineID)
+	tflog.Info(ctx, "Waiting for machine events to complete...")
+	err = c.waitForMachineEvents(ctx, machineID)
 	if err != nil {
 		return err
 	}
@@ -117,9 +154,9 @@ func (c *Client) UpdateMachine(machineID string, machineUpdateConfig MachineUpda
 	return nil
 }
 
-func (c *Client) GetMachine(machineID string) (*Machine, error) {
+func (c *Client) GetMachine(ctx context.Context, machineID string) (*Machine, error) {
 	url := fmt.Sprintf("%s/machines/%s", c.HostURL, machineID)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -135,54 +172,86 @@ func (c *Client) GetMachine(machineID string) (*Machine, error) {
 		return nil, err
 	}
 
-	privateNetwork, err := c.GetPrivateNetwork(machine.NetworkID)
+	privateNetwork, err := c.GetPrivateNetwork(ctx, machine.NetworkID)
 	if err != nil {
-		return nil, fmt.Errorf("could not check network with id %s: %v", machine.NetworkID, err)
+		return nil, fmt.Errorf("could not check network with id %s: %w", machine.NetworkID, err)
 	}
 	machine.IsPrivateNetwork = privateNetwork != nil
 
 	return &machine, nil
 }
 
-func (c *Client) DeleteMachine(machineID string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/machines/%s", c.HostURL, machineID), nil)
+// GetMachines returns all machines of the team.
+// Private networks are fetched once to mark machines in private networks, instead of checking them one by one.
+func (c *Client) GetMachines(ctx context.Context) (*[]Machine, error) {
+	allItems := []Machine{}
+	params := map[string]string{}
+
+	err := fetchAllItems(ctx, c, &allItems, "machines", params)
+	if err != nil {
+		return nil, err
+	}
+
+	privateNetworks, err := c.GetPrivateNetworks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not check machine networks: %w", err)
+	}
+
+	privateNetworkIDs := map[string]bool{}
+	for _, privateNetwork := range *privateNetworks {
+		privateNetworkIDs[privateNetwork.ID] = true
+	}
+
+	for i := range allItems {
+		allItems[i].IsPrivateNetwork = privateNetworkIDs[allItems[i].NetworkID]
+	}
+
+	return &allItems, nil
+}
+
+func (c *Client) DeleteMachine(ctx context.Context, machineID string) error {
+	// Pending operations, e.g. a stop in progress, conflict with the deletion, so wait for them first.
+	// Finished events are skipped, and failure of a pending one doesn't prevent the deletion.
+	err := c.waitForMachineEvents(ctx, machineID)
+	if err != nil {
+		if ctx.Err() != nil {
+			return err
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Pending event of machine '%s' failed, deleting the machine anyway: %s", machineID, err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/machines/%s", c.HostURL, machineID), nil)
 	if err != nil {
 		return err
 	}
 
 	res, err := c.doRequest(req)
 
-	// Check if the error is related to 404 status code
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
-			tflog.Info(*c.Context, fmt.Sprintf("Machine %s not found, assuming already deleted", machineID))
+		if IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Machine %s not found, assuming already deleted", machineID))
 			return nil
 		}
 		// Other errors that are not 404
 		return err
 	}
 
-	tflog.Info(*c.Context, "DELETE response body: "+string(res))
+	tflog.Info(ctx, "DELETE response body: "+string(res))
 
-	// Check periodically if the machine still exists
-	checkInterval := 10 * time.Second
-	maxAttempts := 30 // Max number of attempts before giving up
-	totalWaitTime := checkInterval * time.Duration(maxAttempts)
-	for i := 0; i < maxAttempts; i++ {
-		_, err := c.GetMachine(machineID)
-		if err != nil {
+	// Deletion is processed by the returned event, the event of the deleted machine may be not found already.
+	machineResponse := MashineResponse{}
+	if err := json.Unmarshal(res, &machineResponse); err == nil && machineResponse.Event.ID != "" {
+		tflog.Info(ctx, fmt.Sprintf("Waiting for machine event '%s' to complete, event id: %s", machineResponse.Event.Name, machineResponse.Event.ID))
+		err = c.waitForEvent(ctx, machineResponse.Event.ID)
+		if err != nil && !IsNotFound(err) {
 			return err
 		}
-
-		// Machine still exists, wait for the next check
-		time.Sleep(checkInterval)
 	}
 
-	// If we reach here, the machine still exists after the wait limit
-	return fmt.Errorf("machine %s was not deleted after %f seconds", machineID, totalWaitTime.Seconds())
+	return waitUntilGone(ctx, c, "machine", machineID, c.GetMachine)
 }
 
-func (c *Client) ManageMachineState(machineID string, targetState string) error {
+func (c *Client) ManageMachineState(ctx context.Context, machineID string, targetState string) error {
 	var action string
 
 	switch targetState {
@@ -195,30 +264,30 @@ func (c *Client) ManageMachineState(machineID string, targetState string) error
 	}
 
 	// Get current state
-	machine, err := c.GetMachine(machineID)
+	machine, err := c.GetMachine(ctx, machineID)
 	if err != nil {
-		return fmt.Errorf("failed to get machine %s: %v", machineID, err)
+		return fmt.Errorf("failed to get machine %s: %w", machineID, err)
 	}
 
 	if machine.State == targetState {
-		tflog.Info(*c.Context, fmt.Sprintf("Machine '%s' is already '%s'", machineID, targetState))
+		tflog.Info(ctx, fmt.Sprintf("Machine '%s' is already '%s'", machineID, targetState))
 		return nil
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/machines/%s/%s", c.HostURL, machineID, action), nil)
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/machines/%s/%s", c.HostURL, machineID, action), nil)
 	if err != nil {
 		return err
 	}
 
 	res, err := c.doRequest(req)
-	tflog.Info(*c.Context, "PATCH response body: "+string(res))
+	tflog.Info(ctx, "PATCH response body: "+string(res))
 	if err != nil {
 		return err
 	}
 
 	// Wait for action to complete
-	tflog.Info(*c.Context, fmt.Sprintf("Waiting for machine '%s' to %s", machineID, action))
-	err = c.waitForMachineState(machineID, targetState, 30*time.Minute, 10*time.Second)
+	tflog.Info(ctx, fmt.Sprintf("Waiting for machine '%s' to %s", machineID, action))
+	err = c.waitForMachineState(ctx, machineID, targetState)
 	if err != nil {
 		return err
 	}
@@ -226,27 +295,27 @@ func (c *Client) ManageMachineState(machineID string, targetState string) error
 	return nil
 }
 
-func (c *Client) waitForMachineState(machineID string, desiredState string, timeout time.Duration, pollInterval time.Duration) error {
-	// Create a ticker for polling and a timeout channel
-	ticker := time.NewTicker(pollInterval)
-	timeoutChan := time.After(timeout)
+func (c *Client) waitForMachineState(ctx context.Context, machineID string, desiredState string) error {
+	ctx, cancel := withWaitTimeout(ctx)
+	defer cancel()
 
-	defer ticker.Stop() // Ensure ticker is stopped after the function exits
+	subject := fmt.Sprintf("machine %s to reach state %s", machineID, desiredState)
 
 	for {
-		select {
-		case <-timeoutChan:
-			return fmt.Errorf("timeout reached waiting for machine %s to reach state: %s", machineID, desiredState)
-
-		case <-ticker.C:
-			machine, err := c.GetMachine(machineID)
-			if err != nil {
-				return fmt.Errorf("failed to get machine %s: %v", machineID, err)
-			}
+		if sleepContext(ctx, c.StatePollInterval) != nil {
+			return waitError(ctx, subject)
+		}
 
-			if machine.State == desiredState {
-				return nil // Return if the desired state is reached
+		machine, err := c.GetMachine(ctx, machineID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return waitError(ctx, subject)
 			}
+			return fmt.Errorf("failed to get machine %s: %w", machineID, err)
+		}
+
+		if machine.State == desiredState {
+			return nil // Return if the desired state is reached
 		}
 	}
 }
@@ -56,41 +56,47 @@ type Machine struct {
 }
 
 type MachineCreateConfig struct {
-	Name                  string `json:"name"`        // required
-	MachineType           string `json:"machineType"` // required
-	TemplateID            string `json:"templateId"`  // required
-	DiskSize              int64  `json:"diskSize"`    // required
-	Region                string `json:"region"`      // required
-	NetworkID             string `json:"networkId,omitempty"`
-	PublicIPType          string `json:"publicIpType,omitempty"`
-	StartOnCreate         bool   `json:"startOnCreate"`
-	AutoSnapshotEnabled   *bool  `json:"autoSnapshotEnabled,omitempty"`
-	AutoSnapshotFrequency string `json:"autoSnapshotFrequency,omitempty"`
-	AutoSnapshotSaveCount *int64 `json:"autoSnapshotSaveCount,omitempty"`
-	AutoShutdownEnabled   *bool  `json:"autoShutdownEnabled,omitempty"`
-	AutoShutdownTimeout   *int64 `json:"autoShutdownTimeout,omitempty"`
-	AutoShutdownForce     *bool  `json:"autoShutdownForce,omitempty"`
-	EnableNvlink          *bool  `json:"enableNvlink,omitempty"`
-	TakeInitialSnapshot   *bool  `json:"takeInitialSnapshot,omitempty"`
-	StartupScriptID       string `json:"startupScriptId,omitempty"`
-	EmailPassword         *bool  `json:"emailPassword,omitempty"`
+	Name                   string `json:"name"`        // required
+	MachineType            string `json:"machineType"` // required
+	TemplateID             string `json:"templateId"`  // required
+	DiskSize               int64  `json:"diskSize"`    // required
+	Region                 string `json:"region"`      // required
+	NetworkID              string `json:"networkId,omitempty"`
+	PublicIPType           string `json:"publicIpType,omitempty"`
+	StartOnCreate          bool   `json:"startOnCreate"`
+	AutoSnapshotEnabled    *bool  `json:"autoSnapshotEnabled,omitempty"`
+	AutoSnapshotFrequency  string `json:"autoSnapshotFrequency,omitempty"`
+	AutoSnapshotSaveCount  *int64 `json:"autoSnapshotSaveCount,omitempty"`
+	AutoShutdownEnabled    *bool  `json:"autoShutdownEnabled,omitempty"`
+	AutoShutdownTimeout    *int64 `json:"autoShutdownTimeout,omitempty"`
+	AutoShutdownForce      *bool  `json:"autoShutdownForce,omitempty"`
+	RestorePointEnabled    *bool  `json:"restorePointEnabled,omitempty"`
+	RestorePointFrequency  string `json:"restorePointFrequency,omitempty"`
+	RestorePointSnapshotID string `json:"restorePointSnapshotId,omitempty"`
+	EnableNvlink           *bool  `json:"enableNvlink,omitempty"`
+	TakeInitialSnapshot    *bool  `json:"takeInitialSnapshot,omitempty"`
+	StartupScriptID        string `json:"startupScriptId,omitempty"`
+	EmailPassword          *bool  `json:"emailPassword,omitempty"`
 
 	// TODO: Consider pointer+omit here
 	AccessorIDs []string `json:"accessorIds,omitempty"`
 }
 
 type MachineUpdateConfig struct {
-	Name                  string `json:"name,omitempty"`
-	MachineType           string `json:"machineType,omitempty"`
-	DiskSize              int64  `json:"diskSize,omitempty"`
-	NetworkID             string `json:"networkId,omitempty"`
-	PublicIPType          string `json:"publicIpType,omitempty"`
-	AutoSnapshotEnabled   *bool  `json:"autoSnapshotEnabled,omitempty"`
-	AutoSnapshotFrequency string `json:"autoSnapshotFrequency,omitempty"`
-	AutoSnapshotSaveCount *int64 `json:"autoSnapshotSaveCount,omitempty"`
-	AutoShutdownEnabled   *bool  `json:"autoShutdownEnabled,omitempty"`
-	AutoShutdownTimeout   *int64 `json:"autoShutdownTimeout,omitempty"`
-	AutoShutdownForce     *bool  `json:"autoShutdownForce,omitempty"`
+	Name                   string `json:"name,omitempty"`
+	MachineType            string `json:"machineType,omitempty"`
+	DiskSize               int64  `json:"diskSize,omitempty"`
+	NetworkID              string `json:"networkId,omitempty"`
+	PublicIPType           string `json:"publicIpType,omitempty"`
+	AutoSnapshotEnabled    *bool  `json:"autoSnapshotEnabled,omitempty"`
+	AutoSnapshotFrequency  string `json:"autoSnapshotFrequency,omitempty"`
+	AutoSnapshotSaveCount  *int64 `json:"autoSnapshotSaveCount,omitempty"`
+	AutoShutdownEnabled    *bool  `json:"autoShutdownEnabled,omitempty"`
+	AutoShutdownTimeout    *int64 `json:"autoShutdownTimeout,omitempty"`
+	AutoShutdownForce      *bool  `json:"autoShutdownForce,omitempty"`
+	RestorePointEnabled    *bool  `json:"restorePointEnabled,omitempty"`
+	RestorePointFrequency  string `json:"restorePointFrequency,omitempty"`
+	RestorePointSnapshotID string `json:"restorePointSnapshotId,omitempty"`
 }
 
 type Event struct {
@@ -108,3 +114,7 @@ type MashineResponse struct {
 	Event Event   `json:"event"`
 	Data  Machine `json:"data"`
 }
+
+type MachineAvailability struct {
+	Available bool `json:"available"`
+}
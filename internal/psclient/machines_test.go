@@ -0,0 +1,371 @@
+package psclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func testMachineCreateConfig(name string) psclient.MachineCreateConfig {
+	return psclient.MachineCreateConfig{
+		Name:        name,
+		MachineType: "C2",
+		TemplateID:  "t0nspur5",
+		DiskSize:    50,
+		Region:      "ny2",
+	}
+}
+
+func TestCreateMachine(t *testing.T) {
+	tests := []struct {
+		name          string
+		startOnCreate bool
+		wantState     string
+	}{
+		{name: "stopped", startOnCreate: false, wantState: psclient.MachineStateOff},
+		{name: "started", startOnCreate: true, wantState: psclient.MachineStateReady},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			client := fake.NewClient(t)
+
+			config := testMachineCreateConfig("test")
+			config.StartOnCreate = tt.startOnCreate
+
+			machine, err := client.CreateMachine(context.Background(), config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if machine.State != tt.wantState {
+				t.Errorf("expected state %s, got %s", tt.wantState, machine.State)
+			}
+
+			if machine.PublicIP == nil {
+				t.Error("expected public IP to be assigned")
+			}
+
+			if machine.IsPrivateNetwork {
+				t.Error("expected machine in the default network")
+			}
+		})
+	}
+}
+
+func TestCreateMachineUnavailable(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	fake.SetMachineTypeAvailable("C2", false)
+
+	machine, err := client.CreateMachine(context.Background(), testMachineCreateConfig("test"))
+	if err == nil || !strings.Contains(err.Error(), "not available") {
+		t.Fatalf("expected availability error, got %v", err)
+	}
+
+	if machine != nil {
+		t.Errorf("expected no machine, got %+v", machine)
+	}
+
+	if count := fake.CountRequests(http.MethodPost, "/machines"); count != 0 {
+		t.Errorf("expected machine not to be requested, got %d requests", count)
+	}
+}
+
+func TestCreateMachineAvailabilityError(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	fake.FailRequests(http.MethodGet, "/machines/availability", http.StatusForbidden, 1)
+
+	machine, err := client.CreateMachine(context.Background(), testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatalf("expected machine to be created when availability can't be checked, got %v", err)
+	}
+
+	if fake.Machine(machine.ID) == nil {
+		t.Errorf("expected machine %s to exist", machine.ID)
+	}
+}
+
+func TestCreateMachineEventError(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	message := "out of capacity"
+	fake.ScriptEvents("create", psclienttest.EventStep{State: "in progress"}, psclienttest.EventStep{State: "error", Error: &message, Finished: true})
+
+	machine, err := client.CreateMachine(context.Background(), testMachineCreateConfig("test"))
+	if err == nil || !strings.Contains(err.Error(), message) {
+		t.Fatalf("expected event error, got %v", err)
+	}
+
+	// Created machine is returned, so the caller can keep track of it
+	if machine == nil || machine.ID == "" {
+		t.Fatal("expected partially created machine to be returned")
+	}
+
+	if fake.Machine(machine.ID) == nil {
+		t.Errorf("expected machine %s to exist", machine.ID)
+	}
+}
+
+func TestManageMachineState(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, state := range []string{psclient.MachineStateReady, psclient.MachineStateReady, psclient.MachineStateOff} {
+		err := client.ManageMachineState(ctx, machine.ID, state)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := fake.Machine(machine.ID).State; got != state {
+			t.Errorf("expected state %s, got %s", state, got)
+		}
+	}
+
+	// Machine which is already in the desired state is not started again
+	if count := fake.CountRequests(http.MethodPatch, "/machines/"+machine.ID+"/start"); count != 1 {
+		t.Errorf("expected 1 start request, got %d", count)
+	}
+
+	if err := client.ManageMachineState(ctx, machine.ID, "restarting"); err == nil {
+		t.Error("expected error for invalid state")
+	}
+}
+
+func TestUpdateMachine(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.UpdateMachine(ctx, machine.ID, psclient.MachineUpdateConfig{Name: "renamed", MachineType: "A4000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Update is finished only when its events are done
+	updated, err := client.GetMachine(ctx, machine.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updated.Name != "renamed" || updated.MachineType != "A4000" {
+		t.Errorf("expected machine to be updated, got name %s and type %s", updated.Name, updated.MachineType)
+	}
+
+	if len(updated.Accelerators) != 1 || updated.Accelerators[0].Name != "A4000" {
+		t.Errorf("expected A4000 accelerator, got %+v", updated.Accelerators)
+	}
+
+	// Empty update is not sent
+	putCount := fake.CountRequests(http.MethodPut, "/machines/"+machine.ID)
+	if err := client.UpdateMachine(ctx, machine.ID, psclient.MachineUpdateConfig{}); err != nil {
+		t.Fatal(err)
+	}
+	if count := fake.CountRequests(http.MethodPut, "/machines/"+machine.ID); count != putCount {
+		t.Errorf("expected empty update not to be sent")
+	}
+}
+
+func TestCreateMachineStartEventError(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	message := "no capacity to start"
+	fake.ScriptEvents("start", psclienttest.EventStep{State: "error", Error: &message, Finished: true})
+
+	config := testMachineCreateConfig("test")
+	config.StartOnCreate = true
+
+	machine, err := client.CreateMachine(context.Background(), config)
+	if err == nil || !strings.Contains(err.Error(), message) {
+		t.Fatalf("expected start event error, got %v", err)
+	}
+
+	if machine == nil || machine.ID == "" {
+		t.Fatal("expected partially created machine to be returned")
+	}
+}
+
+func TestDeleteMachine(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteMachine(ctx, machine.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if fake.Machine(machine.ID) != nil {
+		t.Error("expected machine to be deleted")
+	}
+
+	_, err = client.GetMachine(ctx, machine.ID)
+	if !psclient.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+
+	// Already deleted machine is not an error
+	if err := client.DeleteMachine(ctx, machine.ID); err != nil {
+		t.Errorf("expected no error for deleted machine, got %v", err)
+	}
+}
+
+func TestDeleteMachineNotFound(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+
+	if err := client.DeleteMachine(context.Background(), "psm-missing"); err != nil {
+		t.Errorf("expected no error for missing machine, got %v", err)
+	}
+
+	if count := fake.CountRequests(http.MethodDelete, "/machines/psm-missing"); count != 1 {
+		t.Errorf("expected one delete request, got %d", count)
+	}
+}
+
+func TestDeleteMachineWithFailedEvent(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Earlier event failed and was never finished, it must not prevent the deletion
+	message := "out of capacity"
+	fake.AddMachineEvent(psclient.Event{Name: "start", State: "error", MachineID: machine.ID, DtCreated: "2024-01-01T00:00:00Z", Error: &message})
+
+	if err := client.DeleteMachine(ctx, machine.ID); err != nil {
+		t.Fatalf("expected machine with failed event to be deleted, got %v", err)
+	}
+
+	if fake.Machine(machine.ID) != nil {
+		t.Error("expected machine to be deleted")
+	}
+}
+
+// Operation in progress, e.g. a stop started outside of the client, must finish before the deletion.
+func TestDeleteMachineWithPendingEvent(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	config := testMachineCreateConfig("test")
+	config.StartOnCreate = true
+
+	machine, err := client.CreateMachine(ctx, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake.ScriptEvents("stop",
+		psclienttest.EventStep{State: "in progress"},
+		psclienttest.EventStep{State: "in progress"},
+		psclienttest.EventStep{State: "done", Finished: true},
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fake.URL+"/machines/"+machine.ID+"/stop", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+fake.Token)
+
+	res, err := fake.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	stopResponse := psclient.MashineResponse{}
+	if err := json.NewDecoder(res.Body).Decode(&stopResponse); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.DeleteMachine(ctx, machine.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if fake.Machine(machine.ID) != nil {
+		t.Error("expected machine to be deleted")
+	}
+
+	// Stop event is polled through all its steps, and only then the machine is deleted
+	requests := fake.Requests()
+	deletion := slices.Index(requests, "DELETE /machines/"+machine.ID)
+	stopPolls := 0
+	for _, request := range requests[:max(deletion, 0)] {
+		if request == "GET /machine-events/"+stopResponse.Event.ID {
+			stopPolls++
+		}
+	}
+	if stopPolls < 3 {
+		t.Errorf("expected stop event to be polled 3 times before deletion, got %d in requests %v", stopPolls, requests)
+	}
+}
+
+func TestGetMachines(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	privateNetwork, err := client.CreatePrivateNetwork(ctx, psclient.PrivateNetworkCreateConfig{Name: "test", Region: "ny2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{"default-1", "default-2", "private"}
+	for _, name := range names {
+		config := testMachineCreateConfig(name)
+		if name == "private" {
+			config.NetworkID = privateNetwork.ID
+		}
+
+		if _, err := client.CreateMachine(ctx, config); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	machines, err := client.GetMachines(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*machines) != len(names) {
+		t.Fatalf("expected %d machines, got %d", len(names), len(*machines))
+	}
+
+	for _, machine := range *machines {
+		if want := machine.Name == "private"; machine.IsPrivateNetwork != want {
+			t.Errorf("expected machine %s private network flag to be %t", machine.Name, want)
+		}
+	}
+}
@@ -1,14 +1,46 @@
 package psclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func (c *Client) GetPrivateNetwork(id string) (*PrivateNetwork, error) {
+func (c *Client) CreatePrivateNetwork(ctx context.Context, privateNetworkCreateConfig PrivateNetworkCreateConfig) (*PrivateNetwork, error) {
+	rb, err := json.Marshal(privateNetworkCreateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/private-networks", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "POST response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateNetwork := PrivateNetwork{}
+	err = json.Unmarshal(res, &privateNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	return &privateNetwork, nil
+}
+
+func (c *Client) GetPrivateNetwork(ctx context.Context, id string) (*PrivateNetwork, error) {
 	url := fmt.Sprintf("%s/private-networks/%s", c.HostURL, id)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -31,3 +63,65 @@ func (c *Client) GetPrivateNetwork(id string) (*PrivateNetwork, error) {
 
 	return &privateNetwork, nil
 }
+
+func (c *Client) GetPrivateNetworks(ctx context.Context) (*[]PrivateNetwork, error) {
+	allItems := []PrivateNetwork{}
+	params := map[string]string{}
+
+	err := fetchAllItems(ctx, c, &allItems, "private-networks", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &allItems, nil
+}
+
+func (c *Client) UpdatePrivateNetwork(ctx context.Context, id string, privateNetworkUpdateConfig PrivateNetworkUpdateConfig) (*PrivateNetwork, error) {
+	rb, err := json.Marshal(privateNetworkUpdateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/private-networks/%s", c.HostURL, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "PUT response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	privateNetwork := PrivateNetwork{}
+	err = json.Unmarshal(res, &privateNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	return &privateNetwork, nil
+}
+
+func (c *Client) DeletePrivateNetwork(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/private-networks/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+
+	if err != nil {
+		if IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Private network %s not found, assuming already deleted", id))
+			return nil
+		}
+		// Other errors that are not 404
+		return err
+	}
+
+	tflog.Info(ctx, "DELETE response body: "+string(res))
+
+	return nil
+}
@@ -9,3 +9,12 @@ type PrivateNetwork struct {
 	DtCreated string  `json:"dtCreated"`
 	DtDeleted *string `json:"dtDeleted"` // Nullable
 }
+
+type PrivateNetworkCreateConfig struct {
+	Name   string `json:"name"`   // required
+	Region string `json:"region"` // required
+}
+
+type PrivateNetworkUpdateConfig struct {
+	Name string `json:"name,omitempty"`
+}
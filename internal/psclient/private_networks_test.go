@@ -0,0 +1,93 @@
+package psclient_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestPrivateNetworkLifecycle(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreatePrivateNetwork(ctx, psclient.PrivateNetworkCreateConfig{Name: "test", Region: "ny2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	updated, err := client.UpdatePrivateNetwork(ctx, created.ID, psclient.PrivateNetworkUpdateConfig{Name: "renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "renamed" {
+		t.Errorf("expected network to be renamed, got %s", updated.Name)
+	}
+
+	privateNetwork, err := client.GetPrivateNetwork(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if privateNetwork.Name != "renamed" || privateNetwork.Network == "" {
+		t.Errorf("unexpected network %+v", privateNetwork)
+	}
+
+	if err := client.DeletePrivateNetwork(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetPrivateNetwork(ctx, created.ID); !psclient.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGetPrivateNetworkDefault(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Default network is not private, so it's not returned
+	privateNetwork, err := client.GetPrivateNetwork(ctx, machine.NetworkID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if privateNetwork != nil {
+		t.Errorf("expected no private network, got %+v", privateNetwork)
+	}
+}
+
+func TestDeletePrivateNetworkInUse(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	privateNetwork, err := client.CreatePrivateNetwork(ctx, psclient.PrivateNetworkCreateConfig{Name: "test", Region: "ny2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := testMachineCreateConfig("test")
+	config.NetworkID = privateNetwork.ID
+
+	if _, err := client.CreateMachine(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.DeletePrivateNetwork(ctx, privateNetwork.ID)
+	if !psclient.IsConflict(err) {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+
+	// Conflict is not retried, since it's not transient
+	if count := fake.CountRequests(http.MethodDelete, "/private-networks/"+privateNetwork.ID); count != 1 {
+		t.Errorf("expected 1 delete request, got %d", count)
+	}
+}
@@ -0,0 +1,98 @@
+package psclient
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how failed requests are retried by doRequest.
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts, including the first one
+	BaseDelay   time.Duration // Delay before the first retry, doubled for every next one
+	MaxDelay    time.Duration // Upper bound for a single delay, also applies to Retry-After
+	Jitter      float64       // Fraction of the delay to randomize, between 0 and 1
+}
+
+// DefaultRetryPolicy returns the policy used by clients created with NewClient.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   1 * time.Second,
+		MaxDelay:    30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// Statuses which are considered transient, so the request may be sent again.
+var retryableStatuses = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+// Methods which may be safely repeated after any transient failure.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// Returns true if the request should be sent again.
+// Requests with idempotent methods are retried on network errors and transient statuses.
+// Other requests (POST, PATCH) are retried only when rate limited, since in that case
+// the API rejects the request before processing it, so repeating it can't create duplicates.
+func isRetryable(method string, res *http.Response, err error) bool {
+	if err != nil {
+		return idempotentMethods[method]
+	}
+
+	if !retryableStatuses[res.StatusCode] {
+		return false
+	}
+
+	return idempotentMethods[method] || res.StatusCode == http.StatusTooManyRequests
+}
+
+// Returns delay before the next attempt.
+// Retry-After header has priority over exponential backoff, but both are capped by MaxDelay.
+func (p RetryPolicy) delay(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if delay, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(delay, p.MaxDelay)
+		}
+	}
+
+	delay := time.Duration(float64(p.BaseDelay) * math.Pow(2, float64(attempt-1)))
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	if p.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * p.Jitter * float64(delay))
+	}
+
+	return delay
+}
+
+// Parses Retry-After header value, which is either number of seconds or HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
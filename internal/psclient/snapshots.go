@@ -0,0 +1,155 @@
+package psclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Takes a manual snapshot of the machine.
+// If waitForCompletion is set, waits for machine events to finish, so the snapshot is complete on return.
+// If the snapshot is created, but waiting fails, the created snapshot is returned along with the error.
+func (c *Client) CreateSnapshot(ctx context.Context, snapshotCreateConfig SnapshotCreateConfig, waitForCompletion bool) (*Snapshot, error) {
+	rb, err := json.Marshal(snapshotCreateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/snapshots", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "POST response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := Snapshot{}
+	err = json.Unmarshal(res, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if !waitForCompletion {
+		return &snapshot, nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting for snapshot '%s' of machine '%s' to complete", snapshot.ID, snapshotCreateConfig.MachineID))
+	err = c.waitForMachineEvents(ctx, snapshotCreateConfig.MachineID)
+	if err != nil {
+		return &snapshot, err
+	}
+
+	// Fetch and return the completed snapshot
+	completedSnapshot, err := c.GetSnapshot(ctx, snapshot.ID)
+	if err != nil {
+		return &snapshot, err
+	}
+
+	return completedSnapshot, nil
+}
+
+func (c *Client) GetSnapshot(ctx context.Context, id string) (*Snapshot, error) {
+	url := fmt.Sprintf("%s/snapshots/%s", c.HostURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := Snapshot{}
+	err = json.Unmarshal(resBody, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// Returns snapshots of the team, or of the given machine only if machineID is not empty.
+// Snapshots are sorted by creation date from oldest to newest.
+func (c *Client) GetSnapshots(ctx context.Context, machineID string) (*[]Snapshot, error) {
+	allItems := []Snapshot{}
+	params := map[string]string{}
+
+	if machineID != "" {
+		params["machineId"] = machineID
+	}
+
+	err := fetchAllItems(ctx, c, &allItems, "snapshots", params)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(allItems, func(i, j int) bool {
+		time1, _ := time.Parse(time.RFC3339, allItems[i].DtCreated)
+		time2, _ := time.Parse(time.RFC3339, allItems[j].DtCreated)
+		return time1.Before(time2)
+	})
+
+	return &allItems, nil
+}
+
+func (c *Client) UpdateSnapshot(ctx context.Context, id string, snapshotUpdateConfig SnapshotUpdateConfig) (*Snapshot, error) {
+	rb, err := json.Marshal(snapshotUpdateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/snapshots/%s", c.HostURL, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "PUT response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := Snapshot{}
+	err = json.Unmarshal(res, &snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+func (c *Client) DeleteSnapshot(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/snapshots/%s", c.HostURL, id), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.doRequest(req)
+
+	if err != nil {
+		if IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Snapshot %s not found, assuming already deleted", id))
+			return nil
+		}
+		// Other errors that are not 404
+		return err
+	}
+
+	tflog.Info(ctx, "DELETE response body: "+string(res))
+
+	return nil
+}
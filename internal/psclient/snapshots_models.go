@@ -0,0 +1,18 @@
+package psclient
+
+type Snapshot struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	MachineID string  `json:"machineId"`
+	DtCreated string  `json:"dtCreated"`
+	DtDeleted *string `json:"dtDeleted"` // Nullable
+}
+
+type SnapshotCreateConfig struct {
+	MachineID string `json:"machineId"` // required
+	Name      string `json:"name"`      // required
+}
+
+type SnapshotUpdateConfig struct {
+	Name string `json:"name,omitempty"`
+}
@@ -0,0 +1,110 @@
+package psclient_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestSnapshotLifecycle(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := client.CreateMachine(ctx, testMachineCreateConfig("other"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	created, err := client.CreateSnapshot(ctx, psclient.SnapshotCreateConfig{Name: "test", MachineID: machine.ID}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateSnapshot(ctx, psclient.SnapshotCreateConfig{Name: "other", MachineID: other.ID}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	// Snapshots are filtered by machine
+	snapshots, err := client.GetSnapshots(ctx, machine.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*snapshots) != 1 || (*snapshots)[0].ID != created.ID {
+		t.Errorf("expected only snapshot %s, got %+v", created.ID, *snapshots)
+	}
+
+	updated, err := client.UpdateSnapshot(ctx, created.ID, psclient.SnapshotUpdateConfig{Name: "renamed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Name != "renamed" {
+		t.Errorf("expected snapshot to be renamed, got %s", updated.Name)
+	}
+
+	for range 2 {
+		if err := client.DeleteSnapshot(ctx, created.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if count := fake.CountRequests(http.MethodDelete, "/snapshots/"+created.ID); count != 2 {
+		t.Errorf("expected 2 delete requests, got %d", count)
+	}
+
+	if _, err := client.GetSnapshot(ctx, created.ID); !psclient.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+// Snapshot is complete once its machine event is done, unless waiting is disabled.
+func TestCreateSnapshotWait(t *testing.T) {
+	tests := []struct {
+		name              string
+		waitForCompletion bool
+		wantFinished      bool
+	}{
+		{name: "wait", waitForCompletion: true, wantFinished: true},
+		{name: "no wait", waitForCompletion: false, wantFinished: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := psclienttest.NewServer(t)
+			client := fake.NewClient(t)
+			ctx := context.Background()
+
+			machine, err := client.CreateMachine(ctx, testMachineCreateConfig("test"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fake.ScriptEvents("snapshot",
+				psclienttest.EventStep{State: "in progress"},
+				psclienttest.EventStep{State: "in progress"},
+				psclienttest.EventStep{State: "done", Finished: true},
+			)
+
+			if _, err := client.CreateSnapshot(ctx, psclient.SnapshotCreateConfig{Name: "test", MachineID: machine.ID}, tt.waitForCompletion); err != nil {
+				t.Fatal(err)
+			}
+
+			// Listing events doesn't advance them
+			events, err := client.GetMachineEvents(ctx, machine.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, event := range *events {
+				if event.Name == "snapshot" && (event.DtFinished != nil) != tt.wantFinished {
+					t.Errorf("expected snapshot event finished %t, got state %s", tt.wantFinished, event.State)
+				}
+			}
+		})
+	}
+}
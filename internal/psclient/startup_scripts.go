@@ -1,29 +1,29 @@
 package psclient
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func (c *Client) CreateStartupScript(startupScriptCreateConfig StartupScriptCreateConfig) (*StartupScript, error) {
+func (c *Client) CreateStartupScript(ctx context.Context, startupScriptCreateConfig StartupScriptCreateConfig) (*StartupScript, error) {
 	rb, err := json.Marshal(startupScriptCreateConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/startup-scripts", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/startup-scripts", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
 
 	res, err := c.doRequest(req)
 
-	tflog.Info(*c.Context, "POST response body: "+string(res))
+	tflog.Info(ctx, "POST response body: "+string(res))
 
 	if err != nil {
 		return nil, err
@@ -39,9 +39,37 @@ func (c *Client) CreateStartupScript(startupScriptCreateConfig StartupScriptCrea
 	return &startupScript, nil
 }
 
-func (c *Client) GetStartupScript(id string) (*StartupScript, error) {
+func (c *Client) UpdateStartupScript(ctx context.Context, id string, startupScriptUpdateConfig StartupScriptUpdateConfig) (*StartupScript, error) {
+	rb, err := json.Marshal(startupScriptUpdateConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/startup-scripts/%s", c.HostURL, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.doRequest(req)
+
+	tflog.Info(ctx, "PUT response body: "+string(res))
+
+	if err != nil {
+		return nil, err
+	}
+
+	startupScript := StartupScript{}
+	err = json.Unmarshal(res, &startupScript)
+	if err != nil {
+		return nil, err
+	}
+
+	return &startupScript, nil
+}
+
+func (c *Client) GetStartupScript(ctx context.Context, id string) (*StartupScript, error) {
 	url := fmt.Sprintf("%s/startup-scripts/%s", c.HostURL, id)
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -57,43 +85,67 @@ func (c *Client) GetStartupScript(id string) (*StartupScript, error) {
 		return nil, err
 	}
 
+	// Script body is not a part of the startup script data, so it has to be fetched separately
+	script, err := c.getStartupScriptBody(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("could not get script of startup script %s: %w", id, err)
+	}
+	startupScript.Script = &script
+
 	return &startupScript, nil
 }
 
-func (c *Client) DeleteStartupScript(id string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/startup-scripts/%s", c.HostURL, id), nil)
+func (c *Client) getStartupScriptBody(ctx context.Context, id string) (string, error) {
+	url := fmt.Sprintf("%s/startup-scripts/%s/script", c.HostURL, id)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	// Script is returned as JSON string, but fallback to raw body just in case
+	var script string
+	if err := json.Unmarshal(body, &script); err != nil {
+		return string(body), nil
+	}
+
+	return script, nil
+}
+
+func (c *Client) GetStartupScripts(ctx context.Context) (*[]StartupScript, error) {
+	allItems := []StartupScript{}
+	params := map[string]string{}
+
+	err := fetchAllItems(ctx, c, &allItems, "startup-scripts", params)
+	if err != nil {
+		return nil, err
+	}
+
+	return &allItems, nil
+}
+
+func (c *Client) DeleteStartupScript(ctx context.Context, id string) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/startup-scripts/%s", c.HostURL, id), nil)
 	if err != nil {
 		return err
 	}
 
 	res, err := c.doRequest(req)
 
-	// Check if the error is related to 404 status code
 	if err != nil {
-		if strings.Contains(err.Error(), "status: 404") {
-			tflog.Info(*c.Context, fmt.Sprintf("Startup script %s not found, assuming already deleted", id))
+		if IsNotFound(err) {
+			tflog.Info(ctx, fmt.Sprintf("Startup script %s not found, assuming already deleted", id))
 			return nil
 		}
 		// Other errors that are not 404
 		return err
 	}
 
-	tflog.Info(*c.Context, "DELETE response body: "+string(res))
-
-	// Check periodically if the resource still exists
-	checkInterval := 10 * time.Second
-	maxAttempts := 18 // Max number of attempts before giving up
-	totalWaitTime := checkInterval * time.Duration(maxAttempts)
-	for i := 0; i < maxAttempts; i++ {
-		_, err := c.GetStartupScript(id)
-		if err != nil {
-			return err
-		}
-
-		// Resource still exists, wait for the next check
-		time.Sleep(checkInterval)
-	}
+	tflog.Info(ctx, "DELETE response body: "+string(res))
 
-	// If we reach here, the resource still exists after the wait limit
-	return fmt.Errorf("startup script %s was not deleted after %f seconds", id, totalWaitTime.Seconds())
+	return waitUntilGone(ctx, c, "startup script", id, c.GetStartupScript)
 }
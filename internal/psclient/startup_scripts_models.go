@@ -2,9 +2,17 @@ package psclient
 
 // Accelerator represents the structure for each accelerator in the machine.
 type StartupScriptCreateConfig struct {
-	Name      string `json:"name"`
-	Script    string `json:"script"`
-	IsRunOnce bool   `json:"isRunOnce"`
+	Name        string `json:"name"`
+	Script      string `json:"script"`
+	IsRunOnce   bool   `json:"isRunOnce"`
+	Description string `json:"description,omitempty"`
+}
+
+type StartupScriptUpdateConfig struct {
+	Name        string  `json:"name,omitempty"`
+	Script      string  `json:"script,omitempty"`
+	IsRunOnce   *bool   `json:"isRunOnce,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 type StartupScript struct {
@@ -16,4 +24,5 @@ type StartupScript struct {
 	AssignedMachineIDs []string `json:"assignedMachineIds"` // The IDs of machines assigned to this script
 	DtCreated          string   `json:"dtCreated"`          // The creation date of the startup script
 	DtDeleted          *string  `json:"dtDeleted"`          // The deletion date of the startup script (nullable)
+	Script             *string  `json:"script,omitempty"`   // The script body, fetched separately (nullable)
 }
@@ -0,0 +1,79 @@
+package psclient_test
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+	"terraform-provider-paperspace/internal/psclienttest"
+)
+
+func TestStartupScriptLifecycle(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	created, err := client.CreateStartupScript(ctx, psclient.StartupScriptCreateConfig{Name: "test", Script: "echo hello", IsRunOnce: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Script body is fetched separately
+	startupScript, err := client.GetStartupScript(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if startupScript.Script == nil || *startupScript.Script != "echo hello" {
+		t.Errorf("expected script body to be fetched, got %v", startupScript.Script)
+	}
+
+	_, err = client.UpdateStartupScript(ctx, created.ID, psclient.StartupScriptUpdateConfig{Script: "echo updated"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	startupScript, err = client.GetStartupScript(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *startupScript.Script != "echo updated" || startupScript.Name != "test" {
+		t.Errorf("expected only script body to be updated, got %s: %s", startupScript.Name, *startupScript.Script)
+	}
+
+	if err := client.DeleteStartupScript(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.GetStartupScript(ctx, created.ID); !psclient.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestStartupScriptAssignedMachines(t *testing.T) {
+	fake := psclienttest.NewServer(t)
+	client := fake.NewClient(t)
+	ctx := context.Background()
+
+	startupScript, err := client.CreateStartupScript(ctx, psclient.StartupScriptCreateConfig{Name: "test", Script: "echo hello"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := testMachineCreateConfig("test")
+	config.StartupScriptID = startupScript.ID
+
+	machine, err := client.CreateMachine(ctx, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	startupScripts, err := client.GetStartupScripts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assigned := (*startupScripts)[0].AssignedMachineIDs
+	if len(assigned) != 1 || assigned[0] != machine.ID {
+		t.Errorf("expected script to be assigned to machine %s, got %v", machine.ID, assigned)
+	}
+}
@@ -0,0 +1,97 @@
+package psclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultRequestTimeout limits a single request attempt, including reading the response body.
+const DefaultRequestTimeout = 30 * time.Second
+
+// HTTPConfig configures the HTTP client used for API requests, see NewHTTPClient.
+type HTTPConfig struct {
+	// Timeout of a single request attempt, DefaultRequestTimeout if zero
+	Timeout time.Duration
+
+	// Proxy URL for API requests, the standard proxy environment variables (HTTPS_PROXY, NO_PROXY) are used if empty
+	ProxyURL string
+
+	// Path to a PEM file with CA certificates trusted in addition to the system ones,
+	// e.g. of an API gateway with a certificate signed by an internal CA
+	CABundle string
+
+	// Headers added to every request, e.g. for an API gateway.
+	// Headers set by the client itself (Authorization, Content-Type) take precedence.
+	Headers map[string]string
+}
+
+// NewHTTPClient returns an HTTP client for NewClientWithHTTPClient.
+func NewHTTPClient(config HTTPConfig) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme == "" || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q: must be absolute, e.g. http://proxy.example.com:3128", config.ProxyURL)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CABundle != "" {
+		pem, err := os.ReadFile(config.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA bundle: %w", err)
+		}
+
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("CA bundle %s contains no PEM encoded certificates", config.CABundle)
+		}
+
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if len(config.Headers) > 0 {
+		roundTripper = &headerTransport{headers: config.Headers, next: transport}
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = DefaultRequestTimeout
+	}
+
+	return &http.Client{Transport: roundTripper, Timeout: timeout}, nil
+}
+
+// Adds headers which are not set yet to requests.
+type headerTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip must not modify the request, so headers are set on a copy
+	req = req.Clone(req.Context())
+
+	for name, value := range t.headers {
+		if req.Header.Get(name) == "" {
+			req.Header.Set(name, value)
+		}
+	}
+
+	return t.next.RoundTrip(req)
+}
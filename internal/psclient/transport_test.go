@@ -0,0 +1,138 @@
+package psclient_test
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+func TestNewHTTPClientHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Gateway-Key") != "gateway" {
+			t.Errorf("expected extra header, got %q", r.Header.Get("X-Gateway-Key"))
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected Authorization header not to be overridden, got %q", r.Header.Get("Authorization"))
+		}
+	}))
+	defer server.Close()
+
+	httpClient, err := psclient.NewHTTPClient(psclient.HTTPConfig{
+		Headers: map[string]string{"X-Gateway-Key": "gateway", "Authorization": "Bearer gateway"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req.Header.Set("Authorization", "Bearer token")
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	// Headers are added to a copy of the request
+	if req.Header.Get("X-Gateway-Key") != "" {
+		t.Error("expected request not to be modified")
+	}
+}
+
+func TestNewHTTPClientCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	httpClient, err := psclient.NewHTTPClient(psclient.HTTPConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := httpClient.Get(server.URL); err == nil {
+		t.Fatal("expected certificate of the test server not to be trusted by default")
+	}
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caBundle, certificate, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	httpClient, err = psclient.NewHTTPClient(psclient.HTTPConfig{CABundle: caBundle})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := httpClient.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected certificate from the CA bundle to be trusted, got %s", err)
+	}
+	res.Body.Close()
+}
+
+func TestNewHTTPClientProxy(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	httpClient, err := psclient.NewHTTPClient(psclient.HTTPConfig{ProxyURL: proxy.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := httpClient.Get("http://api.paperspace.invalid/v1/machines")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if proxied != "http://api.paperspace.invalid/v1/machines" {
+		t.Errorf("expected request to be sent through the proxy, got %q", proxied)
+	}
+}
+
+func TestNewHTTPClientTimeout(t *testing.T) {
+	httpClient, err := psclient.NewHTTPClient(psclient.HTTPConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if httpClient.Timeout != psclient.DefaultRequestTimeout {
+		t.Errorf("expected default timeout, got %s", httpClient.Timeout)
+	}
+
+	httpClient, err = psclient.NewHTTPClient(psclient.HTTPConfig{Timeout: 2 * time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if httpClient.Timeout != 2*time.Minute {
+		t.Errorf("expected configured timeout, got %s", httpClient.Timeout)
+	}
+}
+
+func TestNewHTTPClientInvalidConfig(t *testing.T) {
+	emptyFile := filepath.Join(t.TempDir(), "empty.pem")
+	if err := os.WriteFile(emptyFile, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]psclient.HTTPConfig{
+		"relative proxy URL": {ProxyURL: "proxy.example.com"},
+		"missing CA bundle":  {CABundle: filepath.Join(t.TempDir(), "missing.pem")},
+		"empty CA bundle":    {CABundle: emptyFile},
+	}
+
+	for name, config := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := psclient.NewHTTPClient(config); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
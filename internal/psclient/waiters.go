@@ -0,0 +1,76 @@
+package psclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultWaitTimeout limits waiting for long-running operations if the context has no deadline.
+	DefaultWaitTimeout = 30 * time.Minute
+
+	DefaultEventPollInterval = 5 * time.Second
+	DefaultStatePollInterval = 10 * time.Second
+)
+
+// Returns context limited by DefaultWaitTimeout, unless the given context already has a deadline.
+// Operation timeouts are normally set by resources, so this is only a safety net for other callers.
+func withWaitTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, DefaultWaitTimeout)
+}
+
+// Pauses for the given duration. Returns error if the context is done earlier.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Returns error describing why waiting for the subject was interrupted.
+func waitError(ctx context.Context, subject string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timeout reached while waiting for %s", subject)
+	}
+
+	return fmt.Errorf("stopped waiting for %s: %w", subject, ctx.Err())
+}
+
+// Polls the resource with the get function until API responds with not found, which means the resource is deleted.
+// Other errors are returned as is, so real failures are not mistaken for successful deletion.
+func waitUntilGone[T any](ctx context.Context, c *Client, kind string, id string, get func(context.Context, string) (*T, error)) error {
+	ctx, cancel := withWaitTimeout(ctx)
+	defer cancel()
+
+	subject := fmt.Sprintf("%s %s deletion", kind, id)
+
+	for {
+		_, err := get(ctx, id)
+		if IsNotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return waitError(ctx, subject)
+			}
+			return err
+		}
+
+		// Resource still exists, wait for the next check
+		if sleepContext(ctx, c.StatePollInterval) != nil {
+			return waitError(ctx, subject)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package psclienttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+// CassetteEnv is the environment variable which selects the cassette mode of acceptance tests.
+const CassetteEnv = "PAPERSPACE_CASSETTE"
+
+// CassetteMode selects whether API requests are recorded to or replayed from a cassette.
+type CassetteMode string
+
+const (
+	// CassetteOff sends requests to the real API without recording them.
+	CassetteOff CassetteMode = ""
+	// CassetteRecord sends requests to the real API and saves them to the cassette once the test passes.
+	CassetteRecord CassetteMode = "record"
+	// CassetteReplay serves responses saved in the cassette, without network access and API key.
+	CassetteReplay CassetteMode = "replay"
+)
+
+// RedactedToken replaces the API key in recorded requests and responses.
+const RedactedToken = "REDACTED"
+
+// Headers of recorded responses, other headers are not needed by psclient and are dropped.
+var cassetteResponseHeaders = []string{"Content-Type", "Retry-After", "X-Request-Id"}
+
+var emailRegexp = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+// CassetteModeFromEnv returns the cassette mode set by CassetteEnv, failing the test on unknown values.
+func CassetteModeFromEnv(t testing.TB) CassetteMode {
+	t.Helper()
+
+	mode := CassetteMode(os.Getenv(CassetteEnv))
+	switch mode {
+	case CassetteOff, CassetteRecord, CassetteReplay:
+		return mode
+	default:
+		t.Fatalf("unknown %s value %q, expected %q or %q", CassetteEnv, mode, CassetteRecord, CassetteReplay)
+		return CassetteOff
+	}
+}
+
+// Cassette is an http.RoundTripper which records API interactions to a file and replays them.
+//
+// Recorded interactions are scrubbed: the API key is replaced by RedactedToken, email addresses
+// by example.com addresses, and request headers are not saved at all. Replayed requests are matched
+// by method, URL and body, and identical requests (e.g. polls of a machine event) get the recorded
+// responses in the recorded order, so long-running operations take the same number of polls.
+type Cassette struct {
+	path      string
+	mode      CassetteMode
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*interaction
+	secrets      []string
+	emails       map[string]string
+}
+
+type interaction struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body,omitempty"`
+	} `json:"request"`
+
+	Response struct {
+		StatusCode int               `json:"status_code"`
+		Headers    map[string]string `json:"headers,omitempty"`
+		Body       string            `json:"body"`
+	} `json:"response"`
+
+	replayed bool
+}
+
+// NewCassette returns a cassette saved in the file at path.
+// When replaying, the test is skipped if the cassette has not been recorded yet.
+// When recording, the cassette is saved once the test passes.
+func NewCassette(t testing.TB, path string, mode CassetteMode) *Cassette {
+	t.Helper()
+
+	c := &Cassette{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		emails:    map[string]string{},
+	}
+
+	switch mode {
+	case CassetteReplay:
+		data, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			t.Skipf("cassette %s is not recorded, run the test with %s=%s to record it", path, CassetteEnv, CassetteRecord)
+		}
+		if err != nil {
+			t.Fatalf("could not read cassette: %s", err)
+		}
+		if err := json.Unmarshal(data, &c.interactions); err != nil {
+			t.Fatalf("could not parse cassette %s: %s", path, err)
+		}
+
+	case CassetteRecord:
+		t.Cleanup(func() {
+			if t.Failed() || t.Skipped() {
+				return
+			}
+			if err := c.save(); err != nil {
+				t.Errorf("could not save cassette: %s", err)
+			}
+		})
+	}
+
+	return c
+}
+
+// HTTPClient returns an HTTP client sending requests through the cassette.
+func (c *Cassette) HTTPClient() *http.Client {
+	return &http.Client{Transport: c, Timeout: 30 * time.Second}
+}
+
+// RoundTrip records or replays the request, depending on the cassette mode.
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Requests are serialized, so they are recorded in the order the API received them
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "); token != "" {
+		c.addSecret(token)
+	}
+
+	if c.mode == CassetteReplay {
+		return c.replay(req, c.scrub(string(body)))
+	}
+
+	return c.record(req, body)
+}
+
+// Sends the request to the real API and records the scrubbed interaction, must be called with the lock held.
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	outReq := req.Clone(req.Context())
+	outReq.Body = io.NopCloser(bytes.NewReader(body))
+	outReq.ContentLength = int64(len(body))
+
+	res, err := c.transport.RoundTrip(outReq)
+	if err != nil {
+		// Network errors are not recorded, only the retried request which succeeded
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &interaction{}
+	i.Request.Method = req.Method
+	i.Request.URL = c.scrub(req.URL.RequestURI())
+	i.Request.Body = c.scrub(string(body))
+	i.Response.StatusCode = res.StatusCode
+	i.Response.Headers = map[string]string{}
+	for _, header := range cassetteResponseHeaders {
+		if value := res.Header.Get(header); value != "" {
+			i.Response.Headers[header] = value
+		}
+	}
+	i.Response.Body = c.scrub(string(resBody))
+
+	c.interactions = append(c.interactions, i)
+
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	return res, nil
+}
+
+// Returns response of the first not yet replayed interaction matching the request, must be called with the lock held.
+func (c *Cassette) replay(req *http.Request, body string) (*http.Response, error) {
+	url := c.scrub(req.URL.RequestURI())
+
+	for _, i := range c.interactions {
+		if i.replayed || i.Request.Method != req.Method || i.Request.URL != url || i.Request.Body != body {
+			continue
+		}
+
+		i.replayed = true
+
+		header := http.Header{}
+		for key, value := range i.Response.Headers {
+			header.Set(key, value)
+		}
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(strings.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("cassette %s has no recorded response for %s %s, record it again with %s=%s",
+		c.path, req.Method, url, CassetteEnv, CassetteRecord)
+}
+
+// Remembers a value which must never be written to the cassette, must be called with the lock held.
+func (c *Cassette) addSecret(secret string) {
+	for _, s := range c.secrets {
+		if s == secret {
+			return
+		}
+	}
+	c.secrets = append(c.secrets, secret)
+}
+
+// Replaces secrets and email addresses in the value, must be called with the lock held.
+// Each address is replaced consistently, so interactions referring to the same user still match.
+func (c *Cassette) scrub(value string) string {
+	for _, secret := range c.secrets {
+		value = strings.ReplaceAll(value, secret, RedactedToken)
+	}
+
+	return emailRegexp.ReplaceAllStringFunc(value, func(email string) string {
+		if strings.HasSuffix(email, "@example.com") {
+			return email
+		}
+
+		replacement, ok := c.emails[email]
+		if !ok {
+			replacement = fmt.Sprintf("user%d@example.com", len(c.emails)+1)
+			c.emails[email] = replacement
+		}
+		return replacement
+	})
+}
+
+func (c *Cassette) save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// SetMinimalDelays sets poll intervals and retry delays of the client to the minimum,
+// e.g. to wait for scripted or replayed machine events without noticeable waiting.
+func SetMinimalDelays(client *psclient.Client) {
+	client.EventPollInterval = time.Millisecond
+	client.StatePollInterval = time.Millisecond
+	client.RetryPolicy.BaseDelay = time.Millisecond
+	client.RetryPolicy.MaxDelay = time.Millisecond
+}
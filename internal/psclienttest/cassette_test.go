@@ -0,0 +1,77 @@
+package psclienttest
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+func TestCassetteRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	ctx := context.Background()
+
+	// Record machine creation against the fake API
+	t.Run("record", func(t *testing.T) {
+		fake := NewServer(t)
+		fake.Token = "secret-token"
+
+		cassette := NewCassette(t, path, CassetteRecord)
+		client, err := psclient.NewClientWithHTTPClient(cassette.HTTPClient(), &fake.URL, &fake.Token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		SetMinimalDelays(client)
+
+		if _, err := client.CreateMachine(ctx, psclient.MachineCreateConfig{Name: "test", MachineType: "C2", TemplateID: "t0nspur5", DiskSize: 50, Region: "ny2"}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "secret-token") {
+		t.Error("cassette contains the API key")
+	}
+
+	// Replay with another token, the fake API is closed by now
+	t.Run("replay", func(t *testing.T) {
+		cassette := NewCassette(t, path, CassetteReplay)
+		host := "http://127.0.0.1:1"
+		token := "other-token"
+
+		client, err := psclient.NewClientWithHTTPClient(cassette.HTTPClient(), &host, &token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		SetMinimalDelays(client)
+
+		machine, err := client.CreateMachine(ctx, psclient.MachineCreateConfig{Name: "test", MachineType: "C2", TemplateID: "t0nspur5", DiskSize: 50, Region: "ny2"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if machine.State != psclient.MachineStateOff {
+			t.Errorf("expected replayed machine to be off, got %s", machine.State)
+		}
+
+		// Every recorded response is replayed once
+		if _, err := client.GetMachines(ctx); err == nil {
+			t.Error("expected error for request not in the cassette")
+		}
+	})
+}
+
+func TestCassetteScrubEmails(t *testing.T) {
+	cassette := NewCassette(t, filepath.Join(t.TempDir(), "cassette.json"), CassetteRecord)
+
+	value := cassette.scrub(`{"email":"jane@paperspace.com","owner":"john@paperspace.com","creator":"jane@paperspace.com","test":"test@example.com"}`)
+	expected := `{"email":"user1@example.com","owner":"user2@example.com","creator":"user1@example.com","test":"test@example.com"}`
+	if value != expected {
+		t.Errorf("expected %s, got %s", expected, value)
+	}
+}
@@ -0,0 +1,121 @@
+package psclienttest
+
+import (
+	"net/http"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+func (s *Server) listCustomTemplates(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	customTemplates := []psclient.CustomTemplate{}
+	for _, customTemplate := range s.customTemplates {
+		customTemplates = append(customTemplates, *customTemplate)
+	}
+
+	writePage(s, w, r, customTemplates, func(ct psclient.CustomTemplate) string { return ct.ID })
+}
+
+// Template is baked by the "template" machine event, machine types become available when it's done.
+func (s *Server) createCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	var config psclient.CustomTemplateCreateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	machine, ok := s.machines[config.MachineID]
+	if !ok {
+		writeNotFound(w, "Machine", config.MachineID)
+		return
+	}
+
+	if machine.State != psclient.MachineStateOff {
+		writeError(w, http.StatusBadRequest, "Machine must be off to create a template")
+		return
+	}
+
+	customTemplate := &psclient.CustomTemplate{
+		ID:                   s.newID("pst"),
+		Name:                 config.Name,
+		AgentType:            machine.AgentType,
+		OperatingSystemLabel: machine.OS,
+		Region:               machine.RegionFull,
+		AvailableMachineTypes: []psclient.AvailableMachineType{
+			{MachineTypeLabel: machine.MachineType, IsAvailable: false},
+		},
+		ParentMachineID: machine.ID,
+		DtCreated:       s.now(),
+	}
+
+	if size, ok := parseDiskSize(machine.StorageTotal); ok {
+		customTemplate.DefaultSizeGb = size
+	}
+
+	s.customTemplates[customTemplate.ID] = customTemplate
+
+	s.newEvent(machine.ID, "template", func() {
+		for i := range customTemplate.AvailableMachineTypes {
+			customTemplate.AvailableMachineTypes[i].IsAvailable = !s.unavailableMachineTypes[customTemplate.AvailableMachineTypes[i].MachineTypeLabel]
+		}
+	})
+
+	writeJSON(w, customTemplate)
+}
+
+func (s *Server) getCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	customTemplate, ok := s.customTemplates[id]
+	if !ok {
+		writeNotFound(w, "Custom template", id)
+		return
+	}
+
+	writeJSON(w, customTemplate)
+}
+
+func (s *Server) updateCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	var config psclient.CustomTemplateUpdateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	customTemplate, ok := s.customTemplates[id]
+	if !ok {
+		writeNotFound(w, "Custom template", id)
+		return
+	}
+
+	if config.Name != "" {
+		customTemplate.Name = config.Name
+	}
+
+	writeJSON(w, customTemplate)
+}
+
+func (s *Server) deleteCustomTemplate(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	customTemplate, ok := s.customTemplates[id]
+	if !ok {
+		writeNotFound(w, "Custom template", id)
+		return
+	}
+
+	delete(s.customTemplates, id)
+
+	writeJSON(w, customTemplate)
+}
@@ -0,0 +1,67 @@
+package psclienttest
+
+import (
+	"net/http"
+	"slices"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+func (s *Server) listMachineAccessors(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.machines[id]; !ok {
+		writeNotFound(w, "Machine", id)
+		return
+	}
+
+	// Accessors are kept in the order they were added
+	writePage(s, w, r, slices.Clone(s.accessors[id]), func(a psclient.MachineAccessor) string { return a.User.ID })
+}
+
+func (s *Server) addMachineAccessor(w http.ResponseWriter, r *http.Request) {
+	var config psclient.MachineAccessorCreateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.machines[id]; !ok {
+		writeNotFound(w, "Machine", id)
+		return
+	}
+
+	accessor := psclient.MachineAccessor{
+		User:      psclient.MachineAccessorUser{ID: config.UserID, Email: config.UserID + "@example.com"},
+		DtCreated: s.now(),
+	}
+	if !slices.ContainsFunc(s.accessors[id], func(a psclient.MachineAccessor) bool { return a.User.ID == config.UserID }) {
+		s.accessors[id] = append(s.accessors[id], accessor)
+	}
+
+	writeJSON(w, accessor)
+}
+
+func (s *Server) removeMachineAccessor(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	userID := r.PathValue("userId")
+
+	index := slices.IndexFunc(s.accessors[id], func(a psclient.MachineAccessor) bool { return a.User.ID == userID })
+	if index == -1 {
+		writeNotFound(w, "Machine accessor", userID)
+		return
+	}
+
+	accessor := s.accessors[id][index]
+	s.accessors[id] = slices.Delete(s.accessors[id], index, index+1)
+
+	writeJSON(w, accessor)
+}
@@ -0,0 +1,164 @@
+package psclienttest
+
+import (
+	"net/http"
+	"sort"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+const eventStatePending = "new"
+
+// EventStep is the event state reported by a single poll of a machine event.
+type EventStep struct {
+	State    string  // Event state, e.g. "in progress", "done" or "error"
+	Error    *string // Error message, if any
+	Finished bool    // Whether the event has finished date set
+}
+
+// Steps of events without a script: one poll in progress, then done.
+var defaultEventScript = []EventStep{
+	{State: "in progress"},
+	{State: "done", Finished: true},
+}
+
+// A machine event, which moves one step forward on every poll.
+type fakeEvent struct {
+	event psclient.Event
+	steps []EventStep
+	step  int // Index of the reported step, -1 before the first poll
+
+	// Machine change made by the event, applied once the last step is reached without error
+	apply func()
+}
+
+// ScriptEvents sets states reported by consecutive polls of machine events with the name,
+// e.g. "create", "start", "stop", "update", "delete" or "template". The last step is repeated once reached.
+// Changes of the event are applied on the last step, unless it has an error message.
+// The script applies to events started after the call.
+func (s *Server) ScriptEvents(name string, steps ...EventStep) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.eventScripts[name] = steps
+}
+
+// AddMachineEvent stores the event as is, e.g. to test reading events history. ID is generated if empty.
+// Returns the event ID.
+func (s *Server) AddMachineEvent(event psclient.Event) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if event.ID == "" {
+		event.ID = s.newID("pse")
+	} else {
+		s.nextID++
+		s.order[event.ID] = s.nextID
+	}
+	s.events[event.ID] = &fakeEvent{event: event, steps: []EventStep{{State: event.State, Error: event.Error, Finished: event.DtFinished != nil}}, step: 0}
+
+	return event.ID
+}
+
+// Starts a new event of the machine, must be called with the lock held.
+func (s *Server) newEvent(machineID string, name string, apply func()) *fakeEvent {
+	steps, ok := s.eventScripts[name]
+	if !ok || len(steps) == 0 {
+		steps = defaultEventScript
+	}
+
+	event := &fakeEvent{
+		event: psclient.Event{
+			ID:        s.newID("pse"),
+			Name:      name,
+			State:     eventStatePending,
+			MachineID: machineID,
+			DtCreated: s.now(),
+		},
+		steps: steps,
+		step:  -1,
+		apply: apply,
+	}
+	s.events[event.event.ID] = event
+
+	return event
+}
+
+// Moves the event to the next step of its script, must be called with the lock held.
+func (s *Server) advanceEvent(event *fakeEvent) {
+	if event.step >= len(event.steps)-1 {
+		return
+	}
+
+	if event.step == -1 {
+		started := s.now()
+		event.event.DtStarted = &started
+	}
+
+	event.step++
+	step := event.steps[event.step]
+
+	event.event.State = step.State
+	event.event.Error = step.Error
+	if step.Finished {
+		finished := s.now()
+		event.event.DtFinished = &finished
+	}
+
+	if event.step == len(event.steps)-1 && step.Error == nil && event.apply != nil {
+		apply := event.apply
+		event.apply = nil
+		apply()
+	}
+}
+
+// Moves unfinished events of the machine forward, must be called with the lock held.
+// Events are advanced in creation order, so their changes are applied in the requested order.
+func (s *Server) advanceMachineEvents(machineID string) {
+	pending := []*fakeEvent{}
+	for _, event := range s.events {
+		if event.event.MachineID == machineID && event.step < len(event.steps)-1 {
+			pending = append(pending, event)
+		}
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return s.order[pending[i].event.ID] < s.order[pending[j].event.ID]
+	})
+
+	for _, event := range pending {
+		s.advanceEvent(event)
+	}
+}
+
+func (s *Server) listMachineEvents(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	machineID := r.URL.Query().Get("machineId")
+
+	events := []psclient.Event{}
+	for _, event := range s.events {
+		if machineID == "" || event.event.MachineID == machineID {
+			events = append(events, event.event)
+		}
+	}
+
+	writePage(s, w, r, events, func(e psclient.Event) string { return e.ID })
+}
+
+func (s *Server) getMachineEvent(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	event, ok := s.events[id]
+	if !ok {
+		writeNotFound(w, "Machine event", id)
+		return
+	}
+
+	s.advanceEvent(event)
+
+	writeJSON(w, event.event)
+}
@@ -0,0 +1,401 @@
+package psclienttest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+// Regions known by the fake, by short name.
+var regions = map[string]string{
+	"ny2":  "East Coast (NY2)",
+	"ca1":  "West Coast (CA1)",
+	"ams1": "Europe (AMS1)",
+}
+
+// Hardware of the machine types known by the fake, other types get the default one.
+var machineTypes = map[string]struct {
+	cpus         int64
+	ram          string
+	accelerators []psclient.Accelerator
+}{
+	"C2":    {cpus: 1, ram: "4294967296"},
+	"C4":    {cpus: 2, ram: "8589934592"},
+	"C3":    {cpus: 2, ram: "4294967296"},
+	"C5":    {cpus: 4, ram: "17179869184"},
+	"P4000": {cpus: 8, ram: "32212254720", accelerators: []psclient.Accelerator{{Name: "P4000", Memory: "8589934592", Count: 1}}},
+	"A4000": {cpus: 8, ram: "48318382080", accelerators: []psclient.Accelerator{{Name: "A4000", Memory: "17179869184", Count: 1}}},
+}
+
+// AddMachine stores the machine as is, e.g. to test reading machines created outside of Terraform.
+// ID is generated if empty. Returns the machine ID.
+func (s *Server) AddMachine(machine psclient.Machine) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if machine.ID == "" {
+		machine.ID = s.newID("psm")
+	} else {
+		s.nextID++
+		s.order[machine.ID] = s.nextID
+	}
+	s.machines[machine.ID] = &machine
+
+	return machine.ID
+}
+
+// Machine returns a copy of the stored machine, or nil if it does not exist.
+func (s *Server) Machine(id string) *psclient.Machine {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	machine, ok := s.machines[id]
+	if !ok {
+		return nil
+	}
+
+	result := *machine
+	return &result
+}
+
+func (s *Server) getMachineAvailability(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	region := r.URL.Query().Get("region")
+	machineType := r.URL.Query().Get("machineType")
+
+	_, knownRegion := regions[region]
+
+	writeJSON(w, psclient.MachineAvailability{
+		Available: knownRegion && !s.unavailableMachineTypes[machineType],
+	})
+}
+
+func (s *Server) listMachines(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	machines := []psclient.Machine{}
+	for _, machine := range s.machines {
+		machines = append(machines, *machine)
+	}
+
+	writePage(s, w, r, machines, func(m psclient.Machine) string { return m.ID })
+}
+
+func (s *Server) createMachine(w http.ResponseWriter, r *http.Request) {
+	var config psclient.MachineCreateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	regionFull, ok := regions[config.Region]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "Unknown region "+config.Region)
+		return
+	}
+
+	if s.unavailableMachineTypes[config.MachineType] {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Machine type %s is not available", config.MachineType))
+		return
+	}
+
+	networkID := defaultNetworkID(config.Region)
+	if config.NetworkID != "" {
+		if _, ok := s.privateNetworks[config.NetworkID]; !ok {
+			writeNotFound(w, "Private network", config.NetworkID)
+			return
+		}
+		networkID = config.NetworkID
+	}
+
+	var startupScript *psclient.StartupScript
+	if config.StartupScriptID != "" {
+		startupScript, ok = s.startupScripts[config.StartupScriptID]
+		if !ok {
+			writeNotFound(w, "Startup script", config.StartupScriptID)
+			return
+		}
+	}
+
+	hardware := machineTypes[config.MachineType]
+	if hardware.cpus == 0 {
+		hardware = machineTypes["C2"]
+	}
+
+	publicIPType := config.PublicIPType
+	if publicIPType == "" {
+		publicIPType = "dynamic"
+	}
+
+	machine := &psclient.Machine{
+		ID:                     s.newID("psm"),
+		Name:                   config.Name,
+		State:                  "provisioning",
+		OS:                     "Ubuntu 22.04",
+		MachineType:            config.MachineType,
+		AgentType:              "LinuxHeadless",
+		CPUs:                   hardware.cpus,
+		RAM:                    hardware.ram,
+		StorageTotal:           fmt.Sprint(config.DiskSize << 30),
+		StorageUsed:            "0",
+		Accelerators:           append([]psclient.Accelerator{}, hardware.accelerators...),
+		RegionFull:             regionFull,
+		PrivateIP:              fmt.Sprintf("10.0.0.%d", s.nextID%250+2),
+		NetworkID:              networkID,
+		PublicIPType:           publicIPType,
+		AutoShutdownEnabled:    valueOf(config.AutoShutdownEnabled),
+		AutoShutdownTimeout:    config.AutoShutdownTimeout,
+		AutoShutdownForce:      valueOf(config.AutoShutdownForce),
+		AutoSnapshotEnabled:    valueOf(config.AutoSnapshotEnabled),
+		AutoSnapshotFrequency:  stringOrNil(config.AutoSnapshotFrequency),
+		AutoSnapshotSaveCount:  config.AutoSnapshotSaveCount,
+		RestorePointEnabled:    valueOf(config.RestorePointEnabled),
+		RestorePointFrequency:  stringOrNil(config.RestorePointFrequency),
+		RestorePointSnapshotID: stringOrNil(config.RestorePointSnapshotID),
+		UsageRate:              0.5,
+		StorageRate:            0.1,
+		DtCreated:              s.now(),
+		DtModified:             s.now(),
+	}
+	s.machines[machine.ID] = machine
+
+	if startupScript != nil {
+		startupScript.AssignedMachineIDs = append(startupScript.AssignedMachineIDs, machine.ID)
+	}
+
+	for _, userID := range config.AccessorIDs {
+		s.accessors[machine.ID] = append(s.accessors[machine.ID], psclient.MachineAccessor{
+			User:      psclient.MachineAccessorUser{ID: userID, Email: userID + "@example.com"},
+			DtCreated: s.now(),
+		})
+	}
+
+	// Public IP is assigned once the machine is provisioned
+	event := s.newEvent(machine.ID, "create", func() {
+		if machine.PublicIPType != "none" {
+			publicIP := fmt.Sprintf("203.0.113.%d", s.order[machine.ID]%250+2)
+			machine.PublicIP = &publicIP
+		}
+
+		// Initial snapshot is used as the restore point, unless another one is set
+		if valueOf(config.TakeInitialSnapshot) && machine.RestorePointSnapshotID == nil {
+			snapshot := s.newSnapshot(machine.ID, "Initial snapshot")
+			machine.RestorePointSnapshotID = &snapshot.ID
+		}
+
+		machine.State = psclient.MachineStateOff
+		if config.StartOnCreate {
+			machine.State = "starting"
+			s.newEvent(machine.ID, "start", func() {
+				machine.State = psclient.MachineStateReady
+			})
+		}
+	})
+
+	writeJSON(w, psclient.MashineResponse{Event: event.event, Data: *machine})
+}
+
+func (s *Server) getMachine(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+
+	// Polling the machine moves its events forward
+	s.advanceMachineEvents(id)
+
+	machine, ok := s.machines[id]
+	if !ok {
+		writeNotFound(w, "Machine", id)
+		return
+	}
+
+	writeJSON(w, machine)
+}
+
+func (s *Server) updateMachine(w http.ResponseWriter, r *http.Request) {
+	var config psclient.MachineUpdateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	machine, ok := s.machines[id]
+	if !ok {
+		writeNotFound(w, "Machine", id)
+		return
+	}
+
+	if (config.MachineType != "" || config.DiskSize != 0) && machine.State != psclient.MachineStateOff {
+		writeError(w, http.StatusBadRequest, "Machine must be off to change machine type or disk size")
+		return
+	}
+
+	if config.NetworkID != "" {
+		if _, ok := s.privateNetworks[config.NetworkID]; !ok {
+			writeNotFound(w, "Private network", config.NetworkID)
+			return
+		}
+	}
+
+	event := s.newEvent(id, "update", func() {
+		if config.Name != "" {
+			machine.Name = config.Name
+		}
+		if config.MachineType != "" {
+			hardware := machineTypes[config.MachineType]
+			if hardware.cpus == 0 {
+				hardware = machineTypes["C2"]
+			}
+			machine.MachineType = config.MachineType
+			machine.CPUs = hardware.cpus
+			machine.RAM = hardware.ram
+			machine.Accelerators = append([]psclient.Accelerator{}, hardware.accelerators...)
+		}
+		if config.DiskSize != 0 {
+			machine.StorageTotal = fmt.Sprint(config.DiskSize << 30)
+		}
+		if config.NetworkID != "" {
+			machine.NetworkID = config.NetworkID
+		}
+		if config.PublicIPType != "" {
+			machine.PublicIPType = config.PublicIPType
+		}
+		if config.AutoSnapshotEnabled != nil {
+			machine.AutoSnapshotEnabled = *config.AutoSnapshotEnabled
+		}
+		if config.AutoSnapshotFrequency != "" {
+			machine.AutoSnapshotFrequency = &config.AutoSnapshotFrequency
+		}
+		if config.AutoSnapshotSaveCount != nil {
+			machine.AutoSnapshotSaveCount = config.AutoSnapshotSaveCount
+		}
+		if config.AutoShutdownEnabled != nil {
+			machine.AutoShutdownEnabled = *config.AutoShutdownEnabled
+		}
+		if config.AutoShutdownTimeout != nil {
+			machine.AutoShutdownTimeout = config.AutoShutdownTimeout
+		}
+		if config.AutoShutdownForce != nil {
+			machine.AutoShutdownForce = *config.AutoShutdownForce
+		}
+		if config.RestorePointEnabled != nil {
+			machine.RestorePointEnabled = *config.RestorePointEnabled
+		}
+		if config.RestorePointFrequency != "" {
+			machine.RestorePointFrequency = &config.RestorePointFrequency
+		}
+		if config.RestorePointSnapshotID != "" {
+			machine.RestorePointSnapshotID = &config.RestorePointSnapshotID
+		}
+		machine.DtModified = s.now()
+	})
+
+	writeJSON(w, psclient.MashineResponse{Event: event.event, Data: *machine})
+}
+
+func (s *Server) deleteMachine(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	machine, ok := s.machines[id]
+	if !ok {
+		writeNotFound(w, "Machine", id)
+		return
+	}
+
+	machine.State = "deleting"
+	event := s.newEvent(id, "delete", func() {
+		delete(s.machines, id)
+		delete(s.accessors, id)
+
+		for _, startupScript := range s.startupScripts {
+			startupScript.AssignedMachineIDs = removeString(startupScript.AssignedMachineIDs, id)
+		}
+	})
+
+	writeJSON(w, psclient.MashineResponse{Event: event.event, Data: *machine})
+}
+
+func (s *Server) startMachine(w http.ResponseWriter, r *http.Request) {
+	s.changeMachineState(w, r, "start", "starting", psclient.MachineStateReady)
+}
+
+func (s *Server) stopMachine(w http.ResponseWriter, r *http.Request) {
+	s.changeMachineState(w, r, "stop", "stopping", psclient.MachineStateOff)
+}
+
+// Starts the action event, the machine stays in the transition state until the event is done.
+func (s *Server) changeMachineState(w http.ResponseWriter, r *http.Request, action string, transitionState string, targetState string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	machine, ok := s.machines[id]
+	if !ok {
+		writeNotFound(w, "Machine", id)
+		return
+	}
+
+	if machine.State == targetState {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Machine is already %s", targetState))
+		return
+	}
+
+	machine.State = transitionState
+	event := s.newEvent(id, action, func() {
+		machine.State = targetState
+	})
+
+	writeJSON(w, psclient.MashineResponse{Event: event.event, Data: *machine})
+}
+
+// Returns ID of the default (non-private) network of the region.
+func defaultNetworkID(region string) string {
+	return "psn-default-" + strings.ToLower(region)
+}
+
+func valueOf[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
+func stringOrNil(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func removeString(values []string, value string) []string {
+	result := []string{}
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Returns disk size in gigabytes from storage total in bytes.
+func parseDiskSize(storageTotal string) (int64, bool) {
+	var bytes int64
+	if _, err := fmt.Sscan(storageTotal, &bytes); err != nil {
+		return 0, false
+	}
+	return bytes >> 30, true
+}
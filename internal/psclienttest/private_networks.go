@@ -0,0 +1,118 @@
+package psclienttest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+func (s *Server) listPrivateNetworks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	privateNetworks := []psclient.PrivateNetwork{}
+	for _, privateNetwork := range s.privateNetworks {
+		privateNetworks = append(privateNetworks, *privateNetwork)
+	}
+
+	writePage(s, w, r, privateNetworks, func(pn psclient.PrivateNetwork) string { return pn.ID })
+}
+
+func (s *Server) createPrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	var config psclient.PrivateNetworkCreateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := regions[config.Region]; !ok {
+		writeError(w, http.StatusBadRequest, "Unknown region "+config.Region)
+		return
+	}
+
+	id := s.newID("psn")
+	privateNetwork := &psclient.PrivateNetwork{
+		ID:        id,
+		Name:      config.Name,
+		Region:    strings.ToUpper(config.Region),
+		Network:   fmt.Sprintf("10.%d.0.0", s.order[id]%250+1),
+		Netmask:   "255.255.255.0",
+		DtCreated: s.now(),
+	}
+	s.privateNetworks[id] = privateNetwork
+
+	writeJSON(w, privateNetwork)
+}
+
+// Default networks are not private, so API responds with null for them instead of 404.
+func (s *Server) getPrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	privateNetwork, ok := s.privateNetworks[id]
+	if !ok {
+		for region := range regions {
+			if id == defaultNetworkID(region) {
+				writeJSON(w, nil)
+				return
+			}
+		}
+
+		writeNotFound(w, "Private network", id)
+		return
+	}
+
+	writeJSON(w, privateNetwork)
+}
+
+func (s *Server) updatePrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	var config psclient.PrivateNetworkUpdateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	privateNetwork, ok := s.privateNetworks[id]
+	if !ok {
+		writeNotFound(w, "Private network", id)
+		return
+	}
+
+	if config.Name != "" {
+		privateNetwork.Name = config.Name
+	}
+
+	writeJSON(w, privateNetwork)
+}
+
+// Networks with machines can't be deleted, like in the real API.
+func (s *Server) deletePrivateNetwork(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	privateNetwork, ok := s.privateNetworks[id]
+	if !ok {
+		writeNotFound(w, "Private network", id)
+		return
+	}
+
+	for _, machine := range s.machines {
+		if machine.NetworkID == id {
+			writeError(w, http.StatusConflict, fmt.Sprintf("Private network %s is used by machine %s", id, machine.ID))
+			return
+		}
+	}
+
+	delete(s.privateNetworks, id)
+
+	writeJSON(w, privateNetwork)
+}
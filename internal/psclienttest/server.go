@@ -0,0 +1,341 @@
+// Package psclienttest provides an in-memory fake of the Paperspace API for tests.
+//
+// The fake serves the endpoints used by psclient over httptest, so both psclient
+// and the provider can be tested without network access and billable resources.
+// Long-running operations are driven by scripted machine events, which advance
+// on every poll instead of wall clock time, so tests are deterministic.
+package psclienttest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+const (
+	// DefaultToken is the API key accepted by the fake unless Server.Token is changed.
+	DefaultToken = "psclienttest-token"
+
+	// DefaultPageSize limits list responses, so pagination is exercised with a few items.
+	DefaultPageSize = 2
+)
+
+// Server is a fake Paperspace API. Fields may be changed before the first request is sent.
+type Server struct {
+	*httptest.Server
+
+	// Token is the accepted API key, requests with other keys fail with 401.
+	Token string
+	// PageSize limits number of items in a single list response, 0 means no limit besides the requested one.
+	PageSize int
+	// Now returns the time used for created, started and finished dates.
+	Now func() time.Time
+	// AuthSession is returned for the token, by default a user with a single team.
+	AuthSession psclient.AuthSession
+
+	mu sync.Mutex
+
+	nextID   int
+	requests []string
+	failures []*failure
+
+	// Event scripts by event name, defaultEventScript is used for other events
+	eventScripts map[string][]EventStep
+
+	unavailableMachineTypes map[string]bool
+
+	machines        map[string]*psclient.Machine
+	events          map[string]*fakeEvent
+	startupScripts  map[string]*psclient.StartupScript
+	scriptBodies    map[string]string
+	customTemplates map[string]*psclient.CustomTemplate
+	privateNetworks map[string]*psclient.PrivateNetwork
+	snapshots       map[string]*psclient.Snapshot
+
+	// Users with access to machines, by machine ID
+	accessors map[string][]psclient.MachineAccessor
+
+	// Creation order of items, lists are returned in this order
+	order map[string]int
+}
+
+// A failure injected with FailRequests.
+type failure struct {
+	method     string
+	path       string
+	statusCode int
+	remaining  int
+}
+
+// NewServer starts a fake API, which is closed when the test finishes.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		Token:    DefaultToken,
+		PageSize: DefaultPageSize,
+		Now:      time.Now,
+
+		AuthSession: psclient.AuthSession{
+			User: psclient.User{
+				ID:        "psu0000",
+				FirstName: "Test",
+				LastName:  "User",
+				Email:     "test@example.com",
+				TeamMemberships: []psclient.TeamMembership{
+					{
+						Team:    psclient.Team{ID: "pst0000", Name: "test", Namespace: "test", IsUserTeam: true},
+						IsOwner: true,
+						IsAdmin: true,
+					},
+				},
+			},
+			Team: psclient.TeamInfo{ID: "pst0000", Namespace: "test", MaxMachines: 10},
+		},
+
+		eventScripts:            map[string][]EventStep{},
+		unavailableMachineTypes: map[string]bool{},
+
+		machines:        map[string]*psclient.Machine{},
+		events:          map[string]*fakeEvent{},
+		startupScripts:  map[string]*psclient.StartupScript{},
+		scriptBodies:    map[string]string{},
+		customTemplates: map[string]*psclient.CustomTemplate{},
+		privateNetworks: map[string]*psclient.PrivateNetwork{},
+		snapshots:       map[string]*psclient.Snapshot{},
+		accessors:       map[string][]psclient.MachineAccessor{},
+		order:           map[string]int{},
+	}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /auth/session", s.getAuthSession)
+
+	mux.HandleFunc("GET /machines", s.listMachines)
+	mux.HandleFunc("POST /machines", s.createMachine)
+	mux.HandleFunc("GET /machines/availability", s.getMachineAvailability)
+	mux.HandleFunc("GET /machines/{id}", s.getMachine)
+	mux.HandleFunc("PUT /machines/{id}", s.updateMachine)
+	mux.HandleFunc("DELETE /machines/{id}", s.deleteMachine)
+	mux.HandleFunc("PATCH /machines/{id}/start", s.startMachine)
+	mux.HandleFunc("PATCH /machines/{id}/stop", s.stopMachine)
+	mux.HandleFunc("GET /machines/{id}/accessors", s.listMachineAccessors)
+	mux.HandleFunc("POST /machines/{id}/accessors", s.addMachineAccessor)
+	mux.HandleFunc("DELETE /machines/{id}/accessors/{userId}", s.removeMachineAccessor)
+
+	mux.HandleFunc("GET /machine-events", s.listMachineEvents)
+	mux.HandleFunc("GET /machine-events/{id}", s.getMachineEvent)
+
+	mux.HandleFunc("GET /startup-scripts", s.listStartupScripts)
+	mux.HandleFunc("POST /startup-scripts", s.createStartupScript)
+	mux.HandleFunc("GET /startup-scripts/{id}", s.getStartupScript)
+	mux.HandleFunc("GET /startup-scripts/{id}/script", s.getStartupScriptBody)
+	mux.HandleFunc("PUT /startup-scripts/{id}", s.updateStartupScript)
+	mux.HandleFunc("DELETE /startup-scripts/{id}", s.deleteStartupScript)
+
+	mux.HandleFunc("GET /custom-templates", s.listCustomTemplates)
+	mux.HandleFunc("POST /custom-templates", s.createCustomTemplate)
+	mux.HandleFunc("GET /custom-templates/{id}", s.getCustomTemplate)
+	mux.HandleFunc("PUT /custom-templates/{id}", s.updateCustomTemplate)
+	mux.HandleFunc("DELETE /custom-templates/{id}", s.deleteCustomTemplate)
+
+	mux.HandleFunc("GET /private-networks", s.listPrivateNetworks)
+	mux.HandleFunc("POST /private-networks", s.createPrivateNetwork)
+	mux.HandleFunc("GET /private-networks/{id}", s.getPrivateNetwork)
+	mux.HandleFunc("PUT /private-networks/{id}", s.updatePrivateNetwork)
+	mux.HandleFunc("DELETE /private-networks/{id}", s.deletePrivateNetwork)
+
+	mux.HandleFunc("GET /snapshots", s.listSnapshots)
+	mux.HandleFunc("POST /snapshots", s.createSnapshot)
+	mux.HandleFunc("GET /snapshots/{id}", s.getSnapshot)
+	mux.HandleFunc("PUT /snapshots/{id}", s.updateSnapshot)
+	mux.HandleFunc("DELETE /snapshots/{id}", s.deleteSnapshot)
+
+	s.Server = httptest.NewServer(s.middleware(mux))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// NewClient returns a client of the fake API with minimal poll intervals and retry delays,
+// so waiting for scripted events takes no noticeable time.
+func (s *Server) NewClient(t testing.TB) *psclient.Client {
+	t.Helper()
+
+	client, err := psclient.NewClient(&s.URL, &s.Token)
+	if err != nil {
+		t.Fatalf("could not create client of fake API: %s", err)
+	}
+
+	SetMinimalDelays(client)
+
+	return client
+}
+
+// FailRequests makes the next count requests with the method and path fail with the status code.
+// Path is matched exactly, e.g. "/machines/psm0001", query string is ignored.
+func (s *Server) FailRequests(method string, path string, statusCode int, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = append(s.failures, &failure{method: method, path: path, statusCode: statusCode, remaining: count})
+}
+
+// SetMachineTypeAvailable changes whether machines of the type can be created, all types are available by default.
+func (s *Server) SetMachineTypeAvailable(machineType string, available bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.unavailableMachineTypes[machineType] = !available
+}
+
+// Requests returns received requests as "METHOD /path" strings, in order.
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string{}, s.requests...)
+}
+
+// CountRequests returns number of received requests with the method and path.
+func (s *Server) CountRequests(method string, path string) int {
+	count := 0
+	for _, request := range s.Requests() {
+		if request == method+" "+path {
+			count++
+		}
+	}
+	return count
+}
+
+// Logs requests, checks authorization and applies injected failures.
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		statusCode := s.takeFailure(r)
+		s.mu.Unlock()
+
+		if r.Header.Get("Authorization") != "Bearer "+s.Token {
+			writeError(w, http.StatusUnauthorized, "Invalid API key")
+			return
+		}
+
+		if statusCode != 0 {
+			writeError(w, statusCode, "Injected failure")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Returns status code of the injected failure matching the request, or 0.
+func (s *Server) takeFailure(r *http.Request) int {
+	for i, f := range s.failures {
+		if f.method == r.Method && f.path == r.URL.Path {
+			f.remaining--
+			if f.remaining <= 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+			return f.statusCode
+		}
+	}
+	return 0
+}
+
+func (s *Server) getAuthSession(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, s.AuthSession)
+}
+
+//// Helpers, must be called with the lock held
+
+// Returns a new unique ID with the prefix and remembers creation order.
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	id := fmt.Sprintf("%s%04d", prefix, s.nextID)
+	s.order[id] = s.nextID
+	return id
+}
+
+func (s *Server) now() string {
+	return s.Now().UTC().Format(time.RFC3339)
+}
+
+// Writes a page of the items, sorted by creation order.
+// The page starts after the item with ID given in "after" parameter and is limited by "limit" parameter and PageSize.
+func writePage[T any](s *Server, w http.ResponseWriter, r *http.Request, items []T, id func(T) string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return s.order[id(items[i])] < s.order[id(items[j])]
+	})
+
+	start := 0
+	if after := r.URL.Query().Get("after"); after != "" {
+		for i, item := range items {
+			if id(item) == after {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	limit := len(items)
+	if value, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && value > 0 {
+		limit = value
+	}
+	if s.PageSize > 0 && s.PageSize < limit {
+		limit = s.PageSize
+	}
+
+	end := min(start+limit, len(items))
+	page := psclient.ItemsResponse[T]{Items: items[start:end], HasMore: end < len(items)}
+	if page.HasMore {
+		page.NextPage = id(items[end-1])
+	}
+
+	writeJSON(w, page)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid request body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"code":    strings.ReplaceAll(strings.ToUpper(http.StatusText(statusCode)), " ", "_"),
+		"message": message,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, kind string, id string) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", kind, id))
+}
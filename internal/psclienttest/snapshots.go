@@ -0,0 +1,127 @@
+package psclienttest
+
+import (
+	"net/http"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+// AddSnapshot stores the snapshot as is, e.g. to test filtering by creation date. ID is generated if empty.
+// Returns the snapshot ID.
+func (s *Server) AddSnapshot(snapshot psclient.Snapshot) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if snapshot.ID == "" {
+		snapshot.ID = s.newID("pss")
+	} else {
+		s.nextID++
+		s.order[snapshot.ID] = s.nextID
+	}
+	s.snapshots[snapshot.ID] = &snapshot
+
+	return snapshot.ID
+}
+
+func (s *Server) listSnapshots(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	machineID := r.URL.Query().Get("machineId")
+
+	snapshots := []psclient.Snapshot{}
+	for _, snapshot := range s.snapshots {
+		if machineID == "" || snapshot.MachineID == machineID {
+			snapshots = append(snapshots, *snapshot)
+		}
+	}
+
+	writePage(s, w, r, snapshots, func(ss psclient.Snapshot) string { return ss.ID })
+}
+
+// Snapshot is returned right away, it's taken by the "snapshot" machine event.
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	var config psclient.SnapshotCreateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.machines[config.MachineID]; !ok {
+		writeNotFound(w, "Machine", config.MachineID)
+		return
+	}
+
+	snapshot := s.newSnapshot(config.MachineID, config.Name)
+	s.newEvent(config.MachineID, "snapshot", nil)
+
+	writeJSON(w, snapshot)
+}
+
+func (s *Server) getSnapshot(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	snapshot, ok := s.snapshots[id]
+	if !ok {
+		writeNotFound(w, "Snapshot", id)
+		return
+	}
+
+	writeJSON(w, snapshot)
+}
+
+func (s *Server) updateSnapshot(w http.ResponseWriter, r *http.Request) {
+	var config psclient.SnapshotUpdateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	snapshot, ok := s.snapshots[id]
+	if !ok {
+		writeNotFound(w, "Snapshot", id)
+		return
+	}
+
+	if config.Name != "" {
+		snapshot.Name = config.Name
+	}
+
+	writeJSON(w, snapshot)
+}
+
+func (s *Server) deleteSnapshot(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	snapshot, ok := s.snapshots[id]
+	if !ok {
+		writeNotFound(w, "Snapshot", id)
+		return
+	}
+
+	delete(s.snapshots, id)
+
+	writeJSON(w, snapshot)
+}
+
+// Stores a new snapshot of the machine, must be called with the lock held.
+func (s *Server) newSnapshot(machineID string, name string) *psclient.Snapshot {
+	snapshot := &psclient.Snapshot{
+		ID:        s.newID("pss"),
+		Name:      name,
+		MachineID: machineID,
+		DtCreated: s.now(),
+	}
+	s.snapshots[snapshot.ID] = snapshot
+
+	return snapshot
+}
@@ -0,0 +1,120 @@
+package psclienttest
+
+import (
+	"net/http"
+
+	"terraform-provider-paperspace/internal/psclient"
+)
+
+func (s *Server) listStartupScripts(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	startupScripts := []psclient.StartupScript{}
+	for _, startupScript := range s.startupScripts {
+		startupScripts = append(startupScripts, *startupScript)
+	}
+
+	writePage(s, w, r, startupScripts, func(ss psclient.StartupScript) string { return ss.ID })
+}
+
+func (s *Server) createStartupScript(w http.ResponseWriter, r *http.Request) {
+	var config psclient.StartupScriptCreateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	startupScript := &psclient.StartupScript{
+		ID:                 s.newID("pss"),
+		Name:               config.Name,
+		Description:        stringOrNil(config.Description),
+		IsEnabled:          true,
+		IsRunOnce:          config.IsRunOnce,
+		AssignedMachineIDs: []string{},
+		DtCreated:          s.now(),
+	}
+	s.startupScripts[startupScript.ID] = startupScript
+	s.scriptBodies[startupScript.ID] = config.Script
+
+	writeJSON(w, startupScript)
+}
+
+func (s *Server) getStartupScript(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	startupScript, ok := s.startupScripts[id]
+	if !ok {
+		writeNotFound(w, "Startup script", id)
+		return
+	}
+
+	writeJSON(w, startupScript)
+}
+
+// Script body is served separately as JSON string, like the real API does.
+func (s *Server) getStartupScriptBody(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	if _, ok := s.startupScripts[id]; !ok {
+		writeNotFound(w, "Startup script", id)
+		return
+	}
+
+	writeJSON(w, s.scriptBodies[id])
+}
+
+func (s *Server) updateStartupScript(w http.ResponseWriter, r *http.Request) {
+	var config psclient.StartupScriptUpdateConfig
+	if !readJSON(w, r, &config) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	startupScript, ok := s.startupScripts[id]
+	if !ok {
+		writeNotFound(w, "Startup script", id)
+		return
+	}
+
+	if config.Name != "" {
+		startupScript.Name = config.Name
+	}
+	if config.Script != "" {
+		s.scriptBodies[id] = config.Script
+	}
+	if config.IsRunOnce != nil {
+		startupScript.IsRunOnce = *config.IsRunOnce
+	}
+	if config.Description != nil {
+		startupScript.Description = config.Description
+	}
+
+	writeJSON(w, startupScript)
+}
+
+func (s *Server) deleteStartupScript(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := r.PathValue("id")
+	startupScript, ok := s.startupScripts[id]
+	if !ok {
+		writeNotFound(w, "Startup script", id)
+		return
+	}
+
+	delete(s.startupScripts, id)
+	delete(s.scriptBodies, id)
+
+	writeJSON(w, startupScript)
+}